@@ -1,35 +1,76 @@
 package main
 
 import (
+	"ctf-tool/pkg/data"
+	"ctf-tool/pkg/game"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 )
 
 const (
-	inputFile  = "questions.json"
-	outputFile = "pkg/data/embedded.go"
-	xorKey     = 0xAA // Simple XOR key
+	defaultInput  = "questions.json"
+	defaultOutput = "pkg/data/embedded.go"
 )
 
 func main() {
-	// Read JSON
-	data, err := ioutil.ReadFile(inputFile)
+	plaintext := flag.Bool("plaintext", false, "keep answers in plaintext instead of storing salted hashes")
+	chain := flag.Bool("chain", true, "encrypt each question (and the final message) under the previous answer")
+	inputFile := flag.String("i", defaultInput, "question pack to read (.json, .yaml, or .toml)")
+	outputFile := flag.String("o", defaultOutput, "output file: a .go file embeds the pack, anything else writes a pack file for -pack")
+	lint := flag.Bool("lint", false, "only check the pack for problems and exit non-zero if any are found")
+	flag.Parse()
+
+	// Read the pack (JSON, YAML, or TOML)
+	raw, err := game.ReadPack(*inputFile)
 	if err != nil {
-		fmt.Printf("Error reading %s: %v\n", inputFile, err)
+		fmt.Printf("Error reading %s: %v\n", *inputFile, err)
 		os.Exit(1)
 	}
 
+	if *lint {
+		issues, err := game.LintPack(raw)
+		if err != nil {
+			fmt.Printf("Error linting %s: %v\n", *inputFile, err)
+			os.Exit(2)
+		}
+		for _, issue := range issues {
+			fmt.Printf("%s: %s\n", *inputFile, issue)
+		}
+		if len(issues) > 0 {
+			os.Exit(1)
+		}
+		fmt.Printf("%s: OK\n", *inputFile)
+		return
+	}
+
+	raw, warnings, err := game.Pack(raw, game.PackOptions{Plaintext: *plaintext, Chain: *chain})
+	if err != nil {
+		fmt.Printf("Error packing %s: %v\n", *inputFile, err)
+		os.Exit(1)
+	}
+	for _, w := range warnings {
+		fmt.Printf("Warning: %s\n", w)
+	}
+
 	// Obfuscate
-	obfuscated := make([]byte, len(data))
-	for i, b := range data {
-		obfuscated[i] = b ^ xorKey
+	obfuscated := data.Obfuscate(raw)
+
+	if !strings.HasSuffix(*outputFile, ".go") {
+		if err := ioutil.WriteFile(*outputFile, obfuscated, 0o644); err != nil {
+			fmt.Printf("Error writing %s: %v\n", *outputFile, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Successfully packed %d bytes into %s\n", len(raw), *outputFile)
+		return
 	}
 
 	// Generate Go code
-	f, err := os.Create(outputFile)
+	f, err := os.Create(*outputFile)
 	if err != nil {
-		fmt.Printf("Error creating %s: %v\n", outputFile, err)
+		fmt.Printf("Error creating %s: %v\n", *outputFile, err)
 		os.Exit(1)
 	}
 	defer f.Close()
@@ -44,14 +85,6 @@ func main() {
 		fmt.Fprintf(f, "0x%02x, ", b)
 	}
 	fmt.Fprintln(f, "\n}")
-	fmt.Fprintln(f, "")
-	fmt.Fprintln(f, "func LoadRawData() []byte {")
-	fmt.Fprintln(f, "\tdecoded := make([]byte, len(embeddedData))")
-	fmt.Fprintln(f, "\tfor i, b := range embeddedData {")
-	fmt.Fprintf(f, "\t\tdecoded[i] = b ^ 0x%02x\n", xorKey)
-	fmt.Fprintln(f, "\t}")
-	fmt.Fprintln(f, "\treturn decoded")
-	fmt.Fprintln(f, "}")
-
-	fmt.Printf("Successfully packed %d bytes into %s\n", len(data), outputFile)
+
+	fmt.Printf("Successfully packed %d bytes into %s\n", len(raw), *outputFile)
 }
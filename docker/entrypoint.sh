@@ -28,7 +28,8 @@ if [ -f "${INSTALL_TEMPLATE}" ]; then
 fi
 
 # Start the ctf-tool built-in web server (replaces ttyd)
-/app/ctf-tool -web -port "${CTF_WEB_PORT}" &
+# nginx forwards every browser from 127.0.0.1 and names the client in X-Real-IP.
+/app/ctf-tool -web -port "${CTF_WEB_PORT}" -trusted-proxies 127.0.0.1 &
 CTF_PID=$!
 
 # Start nginx in foreground
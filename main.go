@@ -1,6 +1,7 @@
 package main
 
 import (
+	"ctf-tool/pkg/data"
 	"ctf-tool/pkg/game"
 	"ctf-tool/pkg/ui"
 	"ctf-tool/pkg/ui/boot"
@@ -12,13 +13,35 @@ import (
 	"fmt"
 	tea "github.com/charmbracelet/bubbletea"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "pack":
+			os.Exit(runPack(os.Args[2:]))
+		case "lint":
+			os.Exit(runLint(os.Args[2:]))
+		case "verify":
+			os.Exit(runVerify(os.Args[2:]))
+		}
+	}
+
 	showcase := flag.Bool("showcase", false, "run UI showcase mode (cycles themes/transitions with placeholder text)")
 	list := flag.Bool("list", false, "list supported boot profiles, themes, and transitions for this terminal")
 	webMode := flag.Bool("web", false, "serve the CTF tool as a web terminal instead of running in the current terminal")
 	port := flag.Int("port", 8080, "port for the web terminal server (used with -web)")
+	trustedProxies := flag.String("trusted-proxies", "", "comma-separated proxy addresses or CIDR ranges whose X-Real-IP/X-Forwarded-For name the client (used with -web)")
+	packPath := flag.String("pack", "", "load questions from this pack file (plain JSON or packed) instead of the embedded pack")
+	session := flag.String("session", "", "save progress under this session token instead of per pack (set by -web for each browser)")
+	noSave := flag.Bool("no-save", false, "do not save or resume progress")
+	seed := flag.Int64("seed", 0, "draw questions of a randomized pack with this seed (shown in the debug snapshot) instead of one derived from -player or -session")
+	player := flag.String("player", "", "player or team name, pre-filled in the name prompt and used for flags and seeds (set by -web from ?player=)")
+	eventsFD := flag.Int("events-fd", 0, "report lockouts on this file descriptor (set by -web)")
+	lockoutsFD := flag.Int("lockouts-fd", 0, "take lockouts from the player's other sessions on this file descriptor (set by -web)")
+	lockout := flag.String("lockout", "", "start locked out as strikes,count,until-unix (set by -web for a client that was locked out)")
 	flag.Parse()
 
 	// --- Web terminal mode ---
@@ -33,8 +56,29 @@ func main() {
 		if *showcase {
 			childArgs = append(childArgs, "-showcase")
 		}
+		if *noSave {
+			childArgs = append(childArgs, "-no-save")
+		}
+		if *packPath != "" {
+			// Fail early rather than in every spawned session.
+			if _, err := game.LoadConfig(*packPath); err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading game data: %v\n", err)
+				os.Exit(1)
+			}
+			abs, err := filepath.Abs(*packPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "cannot resolve pack path: %v\n", err)
+				os.Exit(1)
+			}
+			childArgs = append(childArgs, "-pack", abs)
+		}
+		proxies, err := web.ParseProxies(*trustedProxies)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "-trusted-proxies: %v\n", err)
+			os.Exit(1)
+		}
 		addr := fmt.Sprintf(":%d", *port)
-		if err := web.Serve(addr, self, childArgs); err != nil {
+		if err := web.Serve(addr, self, childArgs, proxies); err != nil {
 			fmt.Fprintf(os.Stderr, "web server: %v\n", err)
 			os.Exit(1)
 		}
@@ -114,9 +158,9 @@ func main() {
 			FinalHint:    "Press F1/F2 to cycle, F3 for auto-demo, Ctrl+X/F12 to quit.",
 		}
 	} else {
-		// Load configuration (embedded)
+		// Load configuration (external pack, falling back to the embedded one)
 		var err error
-		config, err = game.LoadConfig()
+		config, err = game.LoadConfig(*packPath)
 		if err != nil {
 			fmt.Printf("Error loading game data: %v\n", err)
 			os.Exit(1)
@@ -125,10 +169,40 @@ func main() {
 
 	// Initialize UI
 	model := ui.NewModel(config)
+	if *session != "" {
+		model.SetSession(*session)
+	}
+	if name := game.CleanPlayerName(*player); name != "" {
+		model.SetPlayer(name)
+	}
+	if *seed != 0 {
+		model.SetSeed(*seed)
+	}
+	if *eventsFD > 0 {
+		model.Events = os.NewFile(uintptr(*eventsFD), "events")
+	}
+	if *lockout != "" {
+		l, err := game.ParseLockout(*lockout)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		model.ImposeLockout(l)
+	}
 	if *showcase {
 		model.EnableShowcase()
+	} else if !*noSave {
+		path, err := game.ProgressPath(model.PackID, *session)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Progress will not be saved: %v\n", err)
+		} else {
+			model.EnableSaving(path)
+		}
 	}
 	p := tea.NewProgram(model, tea.WithAltScreen())
+	if *lockoutsFD > 0 {
+		go ui.RelayLockouts(os.NewFile(uintptr(*lockoutsFD), "lockouts"), p.Send)
+	}
 
 	finalModel, err := p.Run()
 	if err != nil {
@@ -141,3 +215,148 @@ func main() {
 		fmt.Println(m.DebugSnapshot())
 	}
 }
+
+// runPack implements `ctf-tool pack`: it writes a copy of the running binary
+// with a question pack appended, so event binaries can be produced from a
+// release build without the Go toolchain.
+func runPack(args []string) int {
+	fs := flag.NewFlagSet("pack", flag.ExitOnError)
+	out := fs.String("o", "ctf-tool-packed", "path of the packed binary to write")
+	plaintext := fs.Bool("plaintext", false, "keep answers in plaintext instead of storing salted hashes")
+	chain := fs.Bool("chain", true, "encrypt each question (and the final message) under the previous answer")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s pack [flags] questions.json\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	raw, err := game.ReadPack(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	packed, warnings, err := game.Pack(raw, game.PackOptions{Plaintext: *plaintext, Chain: *chain})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error packing %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot resolve own binary: %v\n", err)
+		return 1
+	}
+	if err := data.WritePackedExecutable(self, *out, packed); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *out, err)
+		return 1
+	}
+
+	fmt.Printf("Successfully packed %s into %s\n", fs.Arg(0), *out)
+	return 0
+}
+
+// runLint implements `ctf-tool lint`: it reports problems in question packs
+// and exits non-zero if any were found.
+func runLint(args []string) int {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s lint questions.json [more.json ...]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	status := 0
+	for _, path := range fs.Args() {
+		if code := lintFile(path); code > status {
+			status = code
+		}
+	}
+	return status
+}
+
+func lintFile(path string) int {
+	raw, err := game.ReadPack(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 2
+	}
+	issues, err := game.LintPack(raw)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 2
+	}
+	for _, issue := range issues {
+		fmt.Printf("%s: %s\n", path, issue)
+	}
+	if len(issues) > 0 {
+		return 1
+	}
+	fmt.Printf("%s: OK\n", path)
+	return 0
+}
+
+// runVerify implements `ctf-tool verify`: it checks submitted flags against
+// the pack's flag template and names the player each one belongs to.
+func runVerify(args []string) int {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	packPath := fs.String("pack", "questions.json", "source pack with flag_template and flag_secret")
+	playersPath := fs.String("players", "", "file with one player id per line, for templates without {{player}}")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s verify [flags] FLAG [FLAG ...]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	config, err := game.LoadConfig(*packPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", *packPath, err)
+		return 2
+	}
+	if config.FlagTemplate == "" || config.FlagSecret == "" {
+		fmt.Fprintf(os.Stderr, "%s: %v (verify against the source pack, not a chained one)\n", *packPath, game.ErrNoFlagSecret)
+		return 2
+	}
+
+	var players []string
+	if *playersPath != "" {
+		raw, err := os.ReadFile(*playersPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *playersPath, err)
+			return 2
+		}
+		for _, line := range strings.Split(string(raw), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				players = append(players, line)
+			}
+		}
+	} else if !game.FlagNamesPlayer(config.FlagTemplate) {
+		fmt.Fprintln(os.Stderr, "The flag template has no {{player}}; pass -players to say whose flags to check.")
+		return 2
+	}
+
+	status := 0
+	for _, submitted := range fs.Args() {
+		if id, ok := game.VerifyFlag(config.FlagTemplate, config.FlagSecret, strings.TrimSpace(submitted), players); ok {
+			fmt.Printf("%s: genuine, player %s\n", submitted, id)
+			continue
+		}
+		fmt.Printf("%s: NOT genuine\n", submitted)
+		status = 1
+	}
+	return status
+}
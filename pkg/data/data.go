@@ -0,0 +1,20 @@
+package data
+
+import "os"
+
+// LoadRawData returns the plain question pack: the payload appended to the
+// executable by `ctf-tool pack` if there is one, otherwise the pack compiled
+// into the binary by cmd/packer.
+func LoadRawData() ([]byte, error) {
+	self, err := os.Executable()
+	if err == nil {
+		payload, found, err := ReadTrailer(self)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return payload, nil
+		}
+	}
+	return Deobfuscate(embeddedData), nil
+}
@@ -2,104 +2,262 @@ package data
 
 var embeddedData = []byte{
 
-	0xd1, 0xa0, 0x8a, 0x8a, 0x88, 0xdb, 0xdf, 0xcf, 0xd9, 0xde, 0xc3, 0xc5, 
-	0xc4, 0xd9, 0x88, 0x90, 0x8a, 0xf1, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0xd1, 
-	0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xc3, 0xce, 0x88, 0x90, 
-	0x8a, 0x9b, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xde, 
-	0xcf, 0xd2, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xe3, 0x8a, 0xd9, 0xda, 0xcf, 
-	0xcb, 0xc1, 0x8a, 0xdd, 0xc3, 0xde, 0xc2, 0xc5, 0xdf, 0xde, 0x8a, 0xcb, 
-	0x8a, 0xc7, 0xc5, 0xdf, 0xde, 0xc2, 0x8a, 0xcb, 0xc4, 0xce, 0x8a, 0xc2, 
-	0xcf, 0xcb, 0xd8, 0x8a, 0xdd, 0xc3, 0xde, 0xc2, 0xc5, 0xdf, 0xde, 0x8a, 
-	0xcf, 0xcb, 0xd8, 0xd9, 0x84, 0x8a, 0xe3, 0x8a, 0xc2, 0xcb, 0xdc, 0xcf, 
-	0x8a, 0xc4, 0xc5, 0x8a, 0xc8, 0xc5, 0xce, 0xd3, 0x86, 0x8a, 0xc8, 0xdf, 
-	0xde, 0x8a, 0xe3, 0x8a, 0xc9, 0xc5, 0xc7, 0xcf, 0x8a, 0xcb, 0xc6, 0xc3, 
-	0xdc, 0xcf, 0x8a, 0xdd, 0xc3, 0xde, 0xc2, 0x8a, 0xdd, 0xc3, 0xc4, 0xce, 
-	0x84, 0x8a, 0xfd, 0xc2, 0xcb, 0xde, 0x8a, 0xcb, 0xc7, 0x8a, 0xe3, 0x95, 
-	0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xcb, 0xc4, 
-	0xd9, 0xdd, 0xcf, 0xd8, 0x88, 0x90, 0x8a, 0x88, 0xcf, 0xc9, 0xc2, 0xc5, 
-	0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xc2, 0xc3, 
-	0xc4, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xe3, 0xde, 0x8d, 0xd9, 0x8a, 0xcb, 
-	0xc6, 0xd9, 0xc5, 0x8a, 0xcb, 0x8a, 0xc9, 0xc5, 0xc7, 0xc7, 0xc5, 0xc4, 
-	0x8a, 0xde, 0xcf, 0xd8, 0xc7, 0xc3, 0xc4, 0xcb, 0xc6, 0x8a, 0xc9, 0xc5, 
-	0xc7, 0xc7, 0xcb, 0xc4, 0xce, 0x84, 0x88, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 
-	0xd7, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0xd1, 0xa0, 0x8a, 0x8a, 0x8a, 
-	0x8a, 0x8a, 0x8a, 0x88, 0xc3, 0xce, 0x88, 0x90, 0x8a, 0x98, 0x86, 0xa0, 
-	0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xde, 0xcf, 0xd2, 0xde, 0x88, 
-	0x90, 0x8a, 0x88, 0xe3, 0x8a, 0xc2, 0xcb, 0xdc, 0xcf, 0x8a, 0xc1, 0xcf, 
-	0xd3, 0xd9, 0x8a, 0xc8, 0xdf, 0xde, 0x8a, 0xc4, 0xc5, 0x8a, 0xc6, 0xc5, 
-	0xc9, 0xc1, 0xd9, 0x84, 0x8a, 0xe3, 0x8a, 0xc2, 0xcb, 0xdc, 0xcf, 0x8a, 
-	0xcb, 0x8a, 0xd9, 0xda, 0xcb, 0xc9, 0xcf, 0x8a, 0xc8, 0xdf, 0xde, 0x8a, 
-	0xc4, 0xc5, 0x8a, 0xd8, 0xc5, 0xc5, 0xc7, 0x84, 0x8a, 0xf3, 0xc5, 0xdf, 
-	0x8a, 0xc9, 0xcb, 0xc4, 0x8a, 0xcf, 0xc4, 0xde, 0xcf, 0xd8, 0x86, 0x8a, 
-	0xc8, 0xdf, 0xde, 0x8a, 0xc4, 0xcf, 0xdc, 0xcf, 0xd8, 0x8a, 0xcd, 0xc5, 
-	0x8a, 0xc5, 0xdf, 0xde, 0xd9, 0xc3, 0xce, 0xcf, 0x84, 0x8a, 0xfd, 0xc2, 
-	0xcb, 0xde, 0x8a, 0xcb, 0xc7, 0x8a, 0xe3, 0x95, 0x88, 0x86, 0xa0, 0x8a, 
-	0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 0xd8, 
-	0x88, 0x90, 0x8a, 0x88, 0xc1, 0xcf, 0xd3, 0xc8, 0xc5, 0xcb, 0xd8, 0xce, 
-	0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xc2, 0xc3, 
-	0xc4, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xf3, 0xc5, 0xdf, 0x8a, 0xcb, 0xd8, 
-	0xcf, 0x8a, 0xdf, 0xd9, 0xc3, 0xc4, 0xcd, 0x8a, 0xc5, 0xc4, 0xcf, 0x8a, 
-	0xd8, 0xc3, 0xcd, 0xc2, 0xde, 0x8a, 0xc4, 0xc5, 0xdd, 0x84, 0x88, 0xa0, 
-	0x8a, 0x8a, 0x8a, 0x8a, 0xd7, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0xd1, 
-	0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xc3, 0xce, 0x88, 0x90, 
-	0x8a, 0x99, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xde, 
-	0xcf, 0xd2, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xfe, 0xc2, 0xcf, 0x8a, 0xc7, 
-	0xc5, 0xd8, 0xcf, 0x8a, 0xd3, 0xc5, 0xdf, 0x8a, 0xde, 0xcb, 0xc1, 0xcf, 
-	0x86, 0x8a, 0xde, 0xc2, 0xcf, 0x8a, 0xc7, 0xc5, 0xd8, 0xcf, 0x8a, 0xd3, 
-	0xc5, 0xdf, 0x8a, 0xc6, 0xcf, 0xcb, 0xdc, 0xcf, 0x8a, 0xc8, 0xcf, 0xc2, 
-	0xc3, 0xc4, 0xce, 0x84, 0x8a, 0xfd, 0xc2, 0xcb, 0xde, 0x8a, 0xcb, 0xc7, 
-	0x8a, 0xe3, 0x95, 0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 
-	0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 0xd8, 0x88, 0x90, 0x8a, 0x88, 0xcc, 
-	0xc5, 0xc5, 0xde, 0xd9, 0xde, 0xcf, 0xda, 0xd9, 0x88, 0x86, 0xa0, 0x8a, 
-	0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xc2, 0xc3, 0xc4, 0xde, 0x88, 0x90, 
-	0x8a, 0x88, 0xfe, 0xc2, 0xc3, 0xc4, 0xc1, 0x8a, 0xcb, 0xc8, 0xc5, 0xdf, 
-	0xde, 0x8a, 0xdd, 0xcb, 0xc6, 0xc1, 0xc3, 0xc4, 0xcd, 0x8a, 0xde, 0xc2, 
-	0xd8, 0xc5, 0xdf, 0xcd, 0xc2, 0x8a, 0xcb, 0x8a, 0xce, 0xc3, 0xcd, 0xc3, 
-	0xde, 0xcb, 0xc6, 0x8a, 0xcc, 0xc5, 0xd8, 0xcf, 0xd9, 0xde, 0x84, 0x88, 
-	0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0xd7, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 
-	0xd1, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 0xc3, 0xce, 0x88, 
-	0x90, 0x8a, 0x9e, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 
-	0xde, 0xcf, 0xd2, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xfd, 0xc2, 0xcb, 0xde, 
-	0x8a, 0xcb, 0xc9, 0xde, 0xd9, 0x8a, 0xc6, 0xc3, 0xc1, 0xcf, 0x8a, 0xcb, 
-	0x8a, 0xc9, 0xcb, 0xde, 0x8a, 0xc8, 0xdf, 0xde, 0x8a, 0xc6, 0xc5, 0xc5, 
-	0xc1, 0xd9, 0x8a, 0xc6, 0xc3, 0xc1, 0xcf, 0x8a, 0xcb, 0x8a, 0xdd, 0xc5, 
-	0xd8, 0xc7, 0x95, 0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 
-	0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 0xd8, 0x88, 0x90, 0x8a, 0x88, 0xc9, 
-	0xcb, 0xde, 0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 
-	0xc2, 0xc3, 0xc4, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xe9, 0xc5, 0xc4, 0xc9, 
-	0xcb, 0xde, 0xcf, 0xc4, 0xcb, 0xde, 0xcf, 0x8a, 0xcc, 0xc3, 0xc6, 0xcf, 
-	0xd9, 0x84, 0x88, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0xd7, 0x86, 0xa0, 0x8a, 
-	0x8a, 0x8a, 0x8a, 0xd1, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x88, 
-	0xc3, 0xce, 0x88, 0x90, 0x8a, 0x9f, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 
-	0x8a, 0x8a, 0x88, 0xde, 0xcf, 0xd2, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xe3, 
-	0x8a, 0xcb, 0xc7, 0x8a, 0xcb, 0x8a, 0xda, 0xc5, 0xd8, 0xde, 0xcb, 0xc6, 
-	0x8a, 0xde, 0xc5, 0x8a, 0xde, 0xc2, 0xcf, 0x8a, 0xc7, 0xcb, 0xc9, 0xc2, 
-	0xc3, 0xc4, 0xcf, 0x8a, 0xd9, 0xc5, 0xdf, 0xc6, 0x84, 0x8a, 0xe3, 0x8a, 
-	0xc6, 0xc3, 0xd9, 0xde, 0xcf, 0xc4, 0x8a, 0xc5, 0xc4, 0x8a, 0xda, 0xc5, 
-	0xd8, 0xde, 0x8a, 0x98, 0x98, 0x84, 0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 
-	0x8a, 0x8a, 0x8a, 0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 0xd8, 0x88, 0x90, 
-	0x8a, 0x88, 0xd9, 0xd9, 0xc2, 0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 
-	0x8a, 0x8a, 0x88, 0xc2, 0xc3, 0xc4, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xf9, 
-	0xcf, 0xc9, 0xdf, 0xd8, 0xcf, 0x8a, 0xf9, 0xc2, 0xcf, 0xc6, 0xc6, 0x84, 
-	0x88, 0xa0, 0x8a, 0x8a, 0x8a, 0x8a, 0xd7, 0xa0, 0x8a, 0x8a, 0xf7, 0x86, 
-	0xa0, 0x8a, 0x8a, 0x88, 0xcc, 0xc3, 0xc4, 0xcb, 0xc6, 0xf5, 0xc7, 0xcf, 
-	0xd9, 0xd9, 0xcb, 0xcd, 0xcf, 0x88, 0x90, 0x8a, 0x88, 0xf9, 0xf3, 0xf9, 
-	0xfe, 0xef, 0xe7, 0x8a, 0xeb, 0xe9, 0xe9, 0xef, 0xf9, 0xf9, 0x8a, 0xed, 
-	0xf8, 0xeb, 0xe4, 0xfe, 0xef, 0xee, 0x84, 0x8a, 0xfe, 0xe2, 0xef, 0x8a, 
-	0xef, 0xed, 0xed, 0x8a, 0xe3, 0xf9, 0x8a, 0xe6, 0xe5, 0xe9, 0xeb, 0xfe, 
-	0xef, 0xee, 0x8a, 0xeb, 0xfe, 0x90, 0x8a, 0x85, 0xde, 0xc7, 0xda, 0x85, 
-	0xcf, 0xcd, 0xcd, 0xf5, 0x99, 0x93, 0x98, 0x92, 0x9b, 0x84, 0xce, 0xcb, 
-	0xde, 0x88, 0x86, 0xa0, 0x8a, 0x8a, 0x88, 0xcc, 0xc3, 0xc4, 0xcb, 0xc6, 
-	0xf5, 0xc2, 0xc3, 0xc4, 0xde, 0x88, 0x90, 0x8a, 0x88, 0xe6, 0xc5, 0xc5, 
-	0xc1, 0x8a, 0xcc, 0xc5, 0xd8, 0x8a, 0xde, 0xc2, 0xcf, 0x8a, 0xc2, 0xc3, 
-	0xce, 0xce, 0xcf, 0xc4, 0x8a, 0xcc, 0xc3, 0xc6, 0xcf, 0x84, 0x88, 0xa0, 
-	0xd7, 0xa0, 
-}
-
-func LoadRawData() []byte {
-	decoded := make([]byte, len(embeddedData))
-	for i, b := range embeddedData {
-		decoded[i] = b ^ 0xaa
-	}
-	return decoded
+	0xd1, 0x88, 0xd9, 0xc9, 0xc2, 0xcf, 0xc7, 0xcb, 0xf5, 0xdc, 0xcf, 0xd8, 
+	0xd9, 0xc3, 0xc5, 0xc4, 0x88, 0x90, 0x99, 0x86, 0x88, 0xdb, 0xdf, 0xcf, 
+	0xd9, 0xde, 0xc3, 0xc5, 0xc4, 0xd9, 0x88, 0x90, 0xf1, 0xd1, 0x88, 0xc3, 
+	0xce, 0x88, 0x90, 0x9b, 0x86, 0x88, 0xde, 0xcf, 0xd2, 0xde, 0x88, 0x90, 
+	0x88, 0xe3, 0x8a, 0xd9, 0xda, 0xcf, 0xcb, 0xc1, 0x8a, 0xdd, 0xc3, 0xde, 
+	0xc2, 0xc5, 0xdf, 0xde, 0x8a, 0xcb, 0x8a, 0xc7, 0xc5, 0xdf, 0xde, 0xc2, 
+	0x8a, 0xcb, 0xc4, 0xce, 0x8a, 0xc2, 0xcf, 0xcb, 0xd8, 0x8a, 0xdd, 0xc3, 
+	0xde, 0xc2, 0xc5, 0xdf, 0xde, 0x8a, 0xcf, 0xcb, 0xd8, 0xd9, 0x84, 0x8a, 
+	0xe3, 0x8a, 0xc2, 0xcb, 0xdc, 0xcf, 0x8a, 0xc4, 0xc5, 0x8a, 0xc8, 0xc5, 
+	0xce, 0xd3, 0x86, 0x8a, 0xc8, 0xdf, 0xde, 0x8a, 0xe3, 0x8a, 0xc9, 0xc5, 
+	0xc7, 0xcf, 0x8a, 0xcb, 0xc6, 0xc3, 0xdc, 0xcf, 0x8a, 0xdd, 0xc3, 0xde, 
+	0xc2, 0x8a, 0xdd, 0xc3, 0xc4, 0xce, 0x84, 0x8a, 0xfd, 0xc2, 0xcb, 0xde, 
+	0x8a, 0xcb, 0xc7, 0x8a, 0xe3, 0x95, 0x88, 0x86, 0x88, 0xcb, 0xc4, 0xd9, 
+	0xdd, 0xcf, 0xd8, 0xd9, 0x88, 0x90, 0xf1, 0xd1, 0x88, 0xc2, 0xcb, 0xd9, 
+	0xc2, 0xcf, 0xd9, 0x88, 0x90, 0xf1, 0x88, 0x9e, 0x93, 0x9d, 0x9a, 0xcc, 
+	0x9b, 0x99, 0xcc, 0x92, 0xc9, 0xce, 0x9a, 0xcb, 0x9d, 0x93, 0x9a, 0xcb, 
+	0xcb, 0x9d, 0x92, 0x9d, 0x93, 0xcb, 0x9e, 0xc8, 0x9c, 0x9f, 0x9e, 0x9f, 
+	0xcf, 0x98, 0x9d, 0x9a, 0xcb, 0x99, 0xc8, 0x9d, 0xcc, 0x98, 0xc9, 0xc9, 
+	0xcf, 0xce, 0x9f, 0x9f, 0x9d, 0xc9, 0x9b, 0x9c, 0x99, 0x9c, 0x93, 0x9c, 
+	0x98, 0x99, 0x9b, 0xce, 0xce, 0x92, 0x93, 0xce, 0x93, 0x99, 0xc8, 0x88, 
+	0xf7, 0xd7, 0xf7, 0x86, 0x88, 0xc2, 0xc3, 0xc4, 0xde, 0xd9, 0x88, 0x90, 
+	0xf1, 0xd1, 0x88, 0xde, 0xcf, 0xd2, 0xde, 0x88, 0x90, 0x88, 0xe3, 0xde, 
+	0x8d, 0xd9, 0x8a, 0xcb, 0xc6, 0xd9, 0xc5, 0x8a, 0xcb, 0x8a, 0xc9, 0xc5, 
+	0xc7, 0xc7, 0xc5, 0xc4, 0x8a, 0xde, 0xcf, 0xd8, 0xc7, 0xc3, 0xc4, 0xcb, 
+	0xc6, 0x8a, 0xc9, 0xc5, 0xc7, 0xc7, 0xcb, 0xc4, 0xce, 0x84, 0x88, 0xd7, 
+	0xf7, 0x86, 0x88, 0xd9, 0xcb, 0xc6, 0xde, 0x88, 0x90, 0x88, 0x92, 0x99, 
+	0x9e, 0xcb, 0xcf, 0xc8, 0x9f, 0x99, 0x9c, 0x9e, 0x98, 0x9c, 0x9f, 0x99, 
+	0x9d, 0xc8, 0x9f, 0xcf, 0x9d, 0x9d, 0x98, 0xcf, 0x9f, 0x9a, 0x9c, 0x9b, 
+	0xcf, 0x9e, 0xcf, 0xcf, 0x92, 0xce, 0x88, 0x86, 0x88, 0xdf, 0xc4, 0xc6, 
+	0xc5, 0xc9, 0xc1, 0xd9, 0x88, 0x90, 0xf1, 0x88, 0x9a, 0x81, 0xf8, 0x9a, 
+	0xde, 0xd2, 0xf3, 0xec, 0xfe, 0xee, 0xc8, 0xf9, 0xf9, 0xff, 0xd9, 0xe6, 
+	0x93, 0xc4, 0xe1, 0x81, 0x9d, 0xde, 0xf0, 0xd9, 0xe1, 0x85, 0xe5, 0xfd, 
+	0xcd, 0xee, 0xf0, 0xde, 0xc0, 0xcc, 0xd9, 0xd0, 0xd2, 0xe3, 0xd2, 0xeb, 
+	0xf0, 0x93, 0x81, 0xe2, 0x9c, 0xf8, 0xdd, 0x93, 0xfc, 0xd2, 0xef, 0xf3, 
+	0xff, 0xc0, 0xc4, 0xfa, 0xcf, 0xc6, 0x93, 0xf8, 0xcf, 0xe5, 0xe6, 0xe7, 
+	0x93, 0xc0, 0x9a, 0xdd, 0xc0, 0x85, 0xc1, 0xe9, 0xcb, 0x9a, 0xe7, 0xdc, 
+	0xed, 0xc4, 0xed, 0xc7, 0x88, 0xf7, 0xd7, 0x86, 0xd1, 0x88, 0xc3, 0xce, 
+	0x88, 0x90, 0x98, 0x86, 0x88, 0xde, 0xcf, 0xd2, 0xde, 0x88, 0x90, 0x88, 
+	0x88, 0x86, 0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 0xd8, 0xd9, 0x88, 0x90, 
+	0xf1, 0xd1, 0x88, 0xc2, 0xcb, 0xd9, 0xc2, 0xcf, 0xd9, 0x88, 0x90, 0xf1, 
+	0x88, 0x92, 0x98, 0x9b, 0x9d, 0x92, 0x99, 0x9e, 0xc9, 0x9c, 0x9d, 0x92, 
+	0x9a, 0x9f, 0x9c, 0xcc, 0x9f, 0x9f, 0x9c, 0xcc, 0x98, 0xc8, 0x9c, 0xc9, 
+	0xc9, 0xc8, 0xcc, 0x92, 0x98, 0x9a, 0x93, 0x9b, 0xcb, 0x9a, 0x9f, 0x98, 
+	0xcc, 0xcf, 0x9f, 0x9c, 0x98, 0x98, 0xce, 0x9a, 0xcc, 0x9a, 0xcc, 0x9b, 
+	0x9e, 0xcb, 0xce, 0xc9, 0x9e, 0xcf, 0x98, 0xcf, 0xc8, 0x9b, 0xcc, 0x92, 
+	0x9d, 0xcc, 0x9a, 0x92, 0xce, 0x88, 0xf7, 0xd7, 0xf7, 0x86, 0x88, 0xd9, 
+	0xcb, 0xc6, 0xde, 0x88, 0x90, 0x88, 0xcf, 0xc9, 0x9d, 0x9d, 0x9d, 0xcb, 
+	0x9e, 0x92, 0x93, 0xc9, 0x9e, 0xcf, 0x9f, 0xcb, 0xce, 0x99, 0xcf, 0x9b, 
+	0x93, 0x92, 0x92, 0xce, 0x9a, 0xc9, 0xcc, 0x98, 0x98, 0x9f, 0xc8, 0x93, 
+	0xc9, 0xcc, 0x88, 0x86, 0x88, 0xd9, 0xcf, 0xcb, 0xc6, 0xcf, 0xce, 0x88, 
+	0x90, 0x88, 0x9a, 0xeb, 0xc0, 0x98, 0xdd, 0xeb, 0xde, 0xe7, 0xd9, 0xc7, 
+	0xc2, 0xf0, 0xe0, 0xe5, 0xd0, 0xd3, 0xe6, 0xd2, 0xcd, 0xe2, 0xf2, 0xf8, 
+	0xf8, 0xe2, 0xc3, 0xe4, 0x9a, 0x9f, 0xcd, 0xeb, 0xd2, 0xcf, 0xce, 0xe9, 
+	0x92, 0xcc, 0xc6, 0xdb, 0xc4, 0xe0, 0xc0, 0x9b, 0xc1, 0x98, 0xda, 0xdb, 
+	0x81, 0x9d, 0xe1, 0x9f, 0x9b, 0xde, 0xd3, 0xcb, 0x99, 0xf2, 0x85, 0xe4, 
+	0xc8, 0xcb, 0xd8, 0xf2, 0xdf, 0xe9, 0xda, 0xe4, 0xd2, 0xfd, 0xc1, 0xda, 
+	0xcf, 0xfe, 0x98, 0xe5, 0xc5, 0xdc, 0xe0, 0xe8, 0xc1, 0xe4, 0xff, 0xc7, 
+	0xd2, 0xe6, 0xc5, 0xd2, 0xf0, 0xc4, 0xee, 0xe4, 0xfa, 0x85, 0xcc, 0xe1, 
+	0xc5, 0x9b, 0xeb, 0xd3, 0xc7, 0xcb, 0xc5, 0x9b, 0xc3, 0xfa, 0xc4, 0x9d, 
+	0xeb, 0x92, 0xd0, 0xd9, 0xeb, 0xfb, 0xfe, 0xfd, 0x93, 0x85, 0xc9, 0x85, 
+	0xf8, 0xde, 0xda, 0xc9, 0xfc, 0xdc, 0x92, 0xff, 0x92, 0xe7, 0xc6, 0xef, 
+	0xeb, 0xe2, 0xe1, 0xfc, 0xc3, 0xfd, 0xe4, 0xdf, 0xcf, 0xec, 0x92, 0xc6, 
+	0xd2, 0xfa, 0xf3, 0xf9, 0x98, 0xcb, 0x9e, 0xd8, 0xfe, 0x9e, 0xc4, 0xe8, 
+	0xc6, 0xed, 0xcd, 0xd2, 0xff, 0x9d, 0xfd, 0xfa, 0xdd, 0x99, 0xdb, 0xfa, 
+	0x93, 0xc8, 0xe7, 0xe5, 0x9f, 0x9c, 0x99, 0xed, 0xe0, 0xc3, 0x81, 0xdb, 
+	0xdf, 0xcb, 0xfc, 0x9f, 0x9c, 0xc2, 0xdb, 0x85, 0xef, 0xe7, 0xc4, 0xce, 
+	0x81, 0x9e, 0xec, 0xcf, 0x98, 0xdc, 0xd9, 0xed, 0xe1, 0xf2, 0xee, 0xef, 
+	0xfc, 0xd2, 0xed, 0xfc, 0xfd, 0xfb, 0xfc, 0xc8, 0xfb, 0xfa, 0xfc, 0xfc, 
+	0x9c, 0xff, 0xf0, 0xc5, 0xfe, 0xd8, 0xf3, 0xe1, 0xc1, 0xe3, 0xc5, 0xf3, 
+	0xce, 0xde, 0xe4, 0xdb, 0xf2, 0xc0, 0xc2, 0xeb, 0xfa, 0x9f, 0xe9, 0x9f, 
+	0xe6, 0xfc, 0xcd, 0xc7, 0xe8, 0x9f, 0x9f, 0xe9, 0xec, 0xc8, 0xc1, 0xcc, 
+	0xf2, 0xfb, 0xce, 0xfd, 0xc5, 0xcb, 0xe5, 0xd8, 0xeb, 0xf8, 0xf3, 0xf8, 
+	0x9e, 0x81, 0xe4, 0xcd, 0xf3, 0xdf, 0x9f, 0xe2, 0x99, 0xfb, 0xe0, 0xfa, 
+	0x9a, 0x97, 0x88, 0x86, 0x88, 0xdf, 0xc4, 0xc6, 0xc5, 0xc9, 0xc1, 0xd9, 
+	0x88, 0x90, 0xf1, 0x88, 0xf9, 0x93, 0xdb, 0xdd, 0x85, 0xc6, 0xdb, 0xfe, 
+	0xc8, 0xec, 0xd2, 0xc7, 0x92, 0x9d, 0x93, 0xc7, 0xcb, 0xcd, 0xff, 0xe8, 
+	0xe5, 0xee, 0xff, 0x81, 0xcc, 0xe0, 0x99, 0x9e, 0xe6, 0xc4, 0xc6, 0x9b, 
+	0xdf, 0x81, 0xec, 0xd2, 0xc7, 0xe8, 0xc0, 0x9d, 0xcb, 0xfc, 0xc7, 0xf9, 
+	0xeb, 0xfe, 0x93, 0xfa, 0xdd, 0xdb, 0xcb, 0x9a, 0xc9, 0xe7, 0x93, 0xfd, 
+	0xe3, 0xf8, 0xcb, 0x85, 0xfe, 0x9d, 0xe7, 0x99, 0xc2, 0xc9, 0xc9, 0xf2, 
+	0xff, 0x81, 0xf0, 0xdf, 0x81, 0xdf, 0xc0, 0xd3, 0xcc, 0x99, 0xdb, 0xfc, 
+	0x88, 0xf7, 0xd7, 0x86, 0xd1, 0x88, 0xc3, 0xce, 0x88, 0x90, 0x99, 0x86, 
+	0x88, 0xde, 0xcf, 0xd2, 0xde, 0x88, 0x90, 0x88, 0x88, 0x86, 0x88, 0xcb, 
+	0xc4, 0xd9, 0xdd, 0xcf, 0xd8, 0xd9, 0x88, 0x90, 0xf1, 0xd1, 0x88, 0xc2, 
+	0xcb, 0xd9, 0xc2, 0xcf, 0xd9, 0x88, 0x90, 0xf1, 0x88, 0x99, 0x9d, 0x9f, 
+	0xcf, 0x9a, 0x98, 0x9c, 0xcb, 0x98, 0x93, 0xce, 0x92, 0x9a, 0x9b, 0xcb, 
+	0x98, 0x9e, 0x98, 0xce, 0xcb, 0xcc, 0x98, 0x9a, 0x98, 0x93, 0x9e, 0xc9, 
+	0x9e, 0x99, 0x9a, 0xcc, 0xc8, 0x93, 0x9e, 0x99, 0x9c, 0x99, 0x99, 0x9e, 
+	0x92, 0x9a, 0xcb, 0x98, 0x9e, 0x9c, 0x9d, 0xcc, 0xcc, 0x99, 0x9d, 0xc9, 
+	0x9a, 0x93, 0xc8, 0x98, 0x99, 0x92, 0x99, 0x98, 0x9d, 0xc9, 0xc9, 0x9e, 
+	0xce, 0x88, 0xf7, 0xd7, 0xf7, 0x86, 0x88, 0xd9, 0xcb, 0xc6, 0xde, 0x88, 
+	0x90, 0x88, 0x9b, 0x9b, 0x99, 0x9e, 0x93, 0x9f, 0x93, 0x98, 0x9b, 0x9c, 
+	0x9c, 0xcc, 0x93, 0x99, 0xce, 0xcf, 0x9e, 0x9c, 0x9c, 0x93, 0xcb, 0x98, 
+	0x92, 0x9f, 0x99, 0xce, 0xcf, 0x9b, 0x9a, 0x9f, 0x9b, 0xcb, 0x88, 0x86, 
+	0x88, 0xd9, 0xcf, 0xcb, 0xc6, 0xcf, 0xce, 0x88, 0x90, 0x88, 0xc2, 0xed, 
+	0xed, 0xe9, 0xe3, 0xc9, 0xe2, 0x98, 0xe7, 0xdd, 0xe4, 0x92, 0xe1, 0xe3, 
+	0xc3, 0xec, 0xdf, 0xf3, 0xc8, 0xe2, 0xfd, 0xf8, 0xc4, 0xd8, 0xc8, 0xeb, 
+	0xed, 0x98, 0xee, 0xfd, 0xee, 0xdf, 0xdd, 0x9e, 0x85, 0xce, 0xe8, 0xfb, 
+	0xe1, 0xc8, 0xc1, 0xe0, 0xe6, 0xd3, 0x98, 0xdf, 0xde, 0xd0, 0xda, 0xfb, 
+	0xfa, 0xf2, 0xfe, 0xd0, 0x9c, 0xdd, 0xda, 0xec, 0xe3, 0x99, 0xdf, 0xe9, 
+	0xcf, 0xe1, 0xc9, 0xeb, 0x9c, 0xf0, 0xfe, 0xf2, 0xc1, 0xe1, 0xe6, 0xe0, 
+	0xce, 0xdb, 0xfe, 0xff, 0x9e, 0x9d, 0xc3, 0xd9, 0xc9, 0xdf, 0xd9, 0x9e, 
+	0xd2, 0xfa, 0xe4, 0x99, 0xd0, 0xe9, 0xe2, 0xf0, 0xda, 0x99, 0xe2, 0x93, 
+	0xdc, 0xed, 0xc3, 0xd8, 0xdd, 0xee, 0xe1, 0xcb, 0xe3, 0xe8, 0xc3, 0xec, 
+	0xda, 0x9f, 0xe9, 0xc6, 0xc9, 0xc6, 0xfa, 0x9f, 0x98, 0xda, 0xf2, 0xd0, 
+	0xcf, 0xc7, 0xef, 0xcd, 0x92, 0xfa, 0x92, 0xcf, 0xe3, 0xeb, 0xc3, 0x9b, 
+	0xe4, 0x99, 0xff, 0xdc, 0xff, 0xc0, 0x81, 0xda, 0xfc, 0xc4, 0x9f, 0x9a, 
+	0xc1, 0xc4, 0xdd, 0x85, 0xd3, 0xe5, 0xe1, 0xe7, 0xc6, 0x85, 0xd0, 0x9c, 
+	0xc3, 0xdf, 0x9a, 0xed, 0xde, 0xee, 0xc2, 0xf8, 0xdd, 0xd3, 0xf9, 0xfe, 
+	0xef, 0xec, 0xe1, 0xc5, 0x98, 0xf2, 0xc4, 0x93, 0xff, 0x81, 0xee, 0xc6, 
+	0xd0, 0x9f, 0xce, 0x9f, 0xef, 0xe3, 0xdf, 0xe2, 0xdd, 0xe1, 0xc9, 0xec, 
+	0xc7, 0xf8, 0xce, 0xcc, 0x9c, 0x99, 0x92, 0xc8, 0xf0, 0xcc, 0xf3, 0xd3, 
+	0xec, 0xcd, 0xcc, 0xcd, 0xd8, 0xdb, 0xf8, 0xec, 0x93, 0xfa, 0xce, 0xe0, 
+	0xef, 0xcf, 0xd8, 0xcd, 0x81, 0xd0, 0xe0, 0xcf, 0x9c, 0x81, 0xed, 0xc0, 
+	0xce, 0xe7, 0xe8, 0xfb, 0xfb, 0xee, 0x9d, 0xfb, 0x97, 0x97, 0x88, 0x86, 
+	0x88, 0xdf, 0xc4, 0xc6, 0xc5, 0xc9, 0xc1, 0xd9, 0x88, 0x90, 0xf1, 0x88, 
+	0x9d, 0x9f, 0xdd, 0xd9, 0xcd, 0xf2, 0xc3, 0xee, 0xe9, 0xfc, 0xfd, 0xe8, 
+	0xe8, 0xc2, 0xce, 0x9d, 0x9b, 0xfb, 0x9b, 0xfd, 0xdd, 0xde, 0xe0, 0x9b, 
+	0xdc, 0xe3, 0x98, 0xfd, 0xcc, 0xe5, 0xf2, 0x92, 0xee, 0xe7, 0xcf, 0xd2, 
+	0xd0, 0xfc, 0xc0, 0xe0, 0xd3, 0xff, 0xfb, 0xe4, 0xd3, 0xd9, 0x9b, 0xcd, 
+	0x9b, 0xc5, 0xee, 0x9f, 0xf8, 0xc4, 0xf8, 0xfb, 0x93, 0x9f, 0x9f, 0xc7, 
+	0xf8, 0x81, 0x9b, 0xfd, 0xff, 0xe5, 0xda, 0xfd, 0xce, 0xe0, 0xd0, 0xfa, 
+	0xfa, 0xd2, 0xd9, 0xed, 0xf3, 0xd3, 0xc3, 0xe4, 0x88, 0xf7, 0xd7, 0x86, 
+	0xd1, 0x88, 0xc3, 0xce, 0x88, 0x90, 0x9e, 0x86, 0x88, 0xde, 0xcf, 0xd2, 
+	0xde, 0x88, 0x90, 0x88, 0x88, 0x86, 0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 
+	0xd8, 0xd9, 0x88, 0x90, 0xf1, 0xd1, 0x88, 0xc2, 0xcb, 0xd9, 0xc2, 0xcf, 
+	0xd9, 0x88, 0x90, 0xf1, 0x88, 0xc8, 0x98, 0x98, 0x92, 0x9c, 0xcb, 0x99, 
+	0xc9, 0xcf, 0x9c, 0x9d, 0xc8, 0x9b, 0x9e, 0xcc, 0x93, 0x99, 0xc9, 0x9b, 
+	0x99, 0xcc, 0xce, 0xc8, 0x9e, 0x99, 0x9e, 0x9e, 0xce, 0x9e, 0xc8, 0x9c, 
+	0xce, 0x9b, 0xcc, 0x92, 0xcc, 0x93, 0x9d, 0xce, 0xce, 0xcf, 0x9b, 0xc9, 
+	0xcf, 0x9d, 0x9a, 0x9e, 0x9d, 0xcc, 0x9b, 0x9d, 0xcb, 0xcf, 0x99, 0x9e, 
+	0x9b, 0x99, 0x9f, 0x93, 0xcb, 0x9e, 0x9a, 0x9f, 0x98, 0x88, 0xf7, 0xd7, 
+	0xf7, 0x86, 0x88, 0xd9, 0xcb, 0xc6, 0xde, 0x88, 0x90, 0x88, 0x9d, 0x9e, 
+	0x92, 0xcf, 0x9a, 0xcf, 0x9c, 0x9a, 0x9d, 0x9a, 0x9d, 0x9f, 0x9e, 0x92, 
+	0xcb, 0x9e, 0x9d, 0x9c, 0x99, 0x99, 0xc9, 0xcc, 0x9a, 0x92, 0x9a, 0x92, 
+	0x9d, 0xcb, 0x98, 0x98, 0xce, 0x93, 0x88, 0x86, 0x88, 0xd9, 0xcf, 0xcb, 
+	0xc6, 0xcf, 0xce, 0x88, 0x90, 0x88, 0xec, 0xce, 0xe2, 0xe1, 0xdd, 0xd2, 
+	0xeb, 0x9b, 0xd8, 0xfa, 0xef, 0xcb, 0xcc, 0x81, 0xdc, 0xe3, 0xcc, 0x81, 
+	0xef, 0xc8, 0xdf, 0x9e, 0xf0, 0x9b, 0xc9, 0x9b, 0xc7, 0x9a, 0xef, 0xdc, 
+	0xff, 0xe2, 0xd0, 0xd0, 0xf0, 0xc5, 0xe5, 0xf8, 0xd0, 0xc2, 0xc8, 0xdd, 
+	0x9b, 0xdb, 0xc0, 0x81, 0xc0, 0xf3, 0xe0, 0x99, 0x9d, 0xf0, 0xdd, 0xfd, 
+	0xe4, 0xdc, 0xf9, 0xc0, 0xef, 0xfd, 0xc7, 0xcc, 0xdc, 0xeb, 0x9f, 0xe9, 
+	0xd3, 0xf9, 0xc2, 0xd9, 0xc1, 0xd0, 0xeb, 0xcc, 0xc1, 0xd0, 0xce, 0xdc, 
+	0xc9, 0xfd, 0x98, 0xe2, 0xe6, 0xf8, 0xcc, 0xe3, 0xcc, 0xe0, 0xcb, 0xfd, 
+	0xee, 0xe1, 0xde, 0xe3, 0xfa, 0xc6, 0x93, 0xff, 0xc1, 0xd2, 0xef, 0xd0, 
+	0xe7, 0xdf, 0xf0, 0xef, 0xda, 0xc9, 0xd9, 0xc7, 0xe5, 0xd9, 0xc9, 0xcd, 
+	0xce, 0xe6, 0x9c, 0xc7, 0xfb, 0x9c, 0x81, 0xe3, 0xc9, 0xc2, 0xdb, 0xeb, 
+	0xe1, 0xe6, 0xc9, 0xd2, 0xc8, 0x92, 0xfd, 0xe7, 0xdb, 0xda, 0xcf, 0xeb, 
+	0xc0, 0xce, 0xd3, 0xc0, 0x9e, 0xf3, 0xfd, 0xec, 0xe8, 0xf3, 0x9a, 0xeb, 
+	0xff, 0xcc, 0xc4, 0xfa, 0x93, 0xe0, 0x99, 0xf2, 0xff, 0xc2, 0xd3, 0xcb, 
+	0xe9, 0xe6, 0xd8, 0xdf, 0x9c, 0xec, 0xe6, 0x98, 0xc4, 0xe3, 0x9f, 0xee, 
+	0xdd, 0xe0, 0xe3, 0xc5, 0x98, 0x9f, 0x99, 0xd8, 0xf8, 0x9e, 0x88, 0x86, 
+	0x88, 0xdf, 0xc4, 0xc6, 0xc5, 0xc9, 0xc1, 0xd9, 0x88, 0x90, 0xf1, 0x88, 
+	0xc0, 0xfd, 0xe1, 0xdc, 0x9f, 0xc3, 0xff, 0xe4, 0x85, 0xcd, 0xe4, 0xc1, 
+	0xc1, 0xc9, 0xe8, 0xda, 0xfc, 0xc2, 0xe8, 0xdb, 0xc1, 0xf3, 0xc7, 0xd3, 
+	0xe7, 0xf8, 0x9b, 0xfe, 0x9b, 0xc8, 0xe1, 0xf9, 0xcf, 0xdc, 0xeb, 0xc6, 
+	0xfc, 0x98, 0xe5, 0xd3, 0xc8, 0xce, 0xc8, 0xd2, 0xc5, 0x85, 0xcd, 0xdb, 
+	0xfd, 0x99, 0xc1, 0xf0, 0xed, 0x9d, 0xe5, 0xd0, 0xc1, 0xd8, 0xf3, 0x81, 
+	0xc1, 0xe4, 0xdc, 0x99, 0xe9, 0x98, 0xc9, 0xf0, 0x81, 0xdb, 0xf8, 0x9b, 
+	0xd8, 0xc2, 0xee, 0xeb, 0xd2, 0xfe, 0xc6, 0xce, 0x88, 0xf7, 0xd7, 0x86, 
+	0xd1, 0x88, 0xc3, 0xce, 0x88, 0x90, 0x9f, 0x86, 0x88, 0xde, 0xcf, 0xd2, 
+	0xde, 0x88, 0x90, 0x88, 0x88, 0x86, 0x88, 0xcb, 0xc4, 0xd9, 0xdd, 0xcf, 
+	0xd8, 0xd9, 0x88, 0x90, 0xf1, 0xd1, 0x88, 0xc2, 0xcb, 0xd9, 0xc2, 0xcf, 
+	0xd9, 0x88, 0x90, 0xf1, 0x88, 0x9e, 0x9e, 0x9b, 0xcc, 0xcb, 0xc9, 0x9e, 
+	0xcb, 0x92, 0xcc, 0x9e, 0x9b, 0xc9, 0x9f, 0x9a, 0x93, 0x98, 0xcf, 0x9a, 
+	0xc8, 0x9b, 0xce, 0x9d, 0x98, 0x9b, 0x93, 0x9e, 0x9f, 0x9a, 0xcf, 0x92, 
+	0xcc, 0x9d, 0x9a, 0xcc, 0x9a, 0xcf, 0x92, 0xcc, 0xcc, 0x9b, 0xcb, 0x9d, 
+	0xcf, 0xc9, 0xcb, 0x9c, 0x9d, 0xcf, 0x9f, 0xcf, 0xce, 0x92, 0x92, 0x9e, 
+	0xc9, 0x9c, 0x92, 0x92, 0xcb, 0x93, 0xc8, 0x93, 0xc9, 0x88, 0xf7, 0xd7, 
+	0x86, 0xd1, 0x88, 0xc2, 0xcb, 0xd9, 0xc2, 0xcf, 0xd9, 0x88, 0x90, 0xf1, 
+	0x88, 0x99, 0x9a, 0xcb, 0x99, 0xcb, 0xc9, 0x99, 0x92, 0xc9, 0x9b, 0x9c, 
+	0x9a, 0xcb, 0x93, 0x99, 0x9f, 0x92, 0xce, 0xcc, 0x9e, 0x9f, 0x9c, 0xc9, 
+	0xcb, 0x9b, 0x93, 0x9d, 0xc9, 0x9e, 0x92, 0x92, 0x9f, 0x9b, 0xc8, 0x99, 
+	0x93, 0xcc, 0xcf, 0x9a, 0xce, 0xc9, 0x9c, 0x99, 0x9f, 0x99, 0x9f, 0xce, 
+	0x99, 0xcf, 0x9e, 0xcf, 0xcc, 0xc9, 0xce, 0x93, 0xcb, 0x99, 0x98, 0x99, 
+	0x9e, 0xc9, 0x99, 0xc9, 0x98, 0x88, 0x86, 0x88, 0xc8, 0x9f, 0x92, 0x92, 
+	0x92, 0xcc, 0x92, 0x9b, 0x93, 0x9d, 0xce, 0x9f, 0xc9, 0x92, 0xcb, 0x99, 
+	0xc8, 0xc9, 0x9f, 0x9c, 0x9e, 0x9d, 0x9d, 0xc8, 0xc8, 0xc9, 0x98, 0x9c, 
+	0x99, 0x9e, 0xcb, 0x9a, 0xcc, 0xc8, 0x9e, 0x98, 0xce, 0x93, 0x99, 0xce, 
+	0x9c, 0x9e, 0x93, 0xc9, 0x9a, 0xce, 0x9c, 0x9b, 0xcb, 0x9e, 0xcc, 0xce, 
+	0x9f, 0xc8, 0xc8, 0x9b, 0xc9, 0x92, 0xc9, 0xc9, 0x9f, 0xc8, 0x9a, 0x98, 
+	0x88, 0xf7, 0xd7, 0x86, 0xd1, 0x88, 0xc2, 0xcb, 0xd9, 0xc2, 0xcf, 0xd9, 
+	0x88, 0x90, 0xf1, 0x88, 0x9f, 0x9d, 0xcf, 0xc9, 0xc8, 0x9c, 0x9a, 0xcc, 
+	0xc8, 0x93, 0x9a, 0x9c, 0xc8, 0x9c, 0x99, 0xcf, 0x9e, 0xc8, 0xc9, 0x99, 
+	0xc8, 0x9e, 0x98, 0x9f, 0xcb, 0x9f, 0xc8, 0x9f, 0x9d, 0x92, 0xcc, 0x9b, 
+	0x9c, 0x98, 0xc8, 0xcc, 0x9f, 0xcb, 0x9c, 0x9a, 0xc9, 0xce, 0x92, 0xce, 
+	0x93, 0xc9, 0xc9, 0x9b, 0xcf, 0x99, 0x92, 0xcc, 0xc9, 0x9f, 0xc9, 0xce, 
+	0xcf, 0x9c, 0x99, 0xce, 0x9d, 0x98, 0xc9, 0x99, 0x88, 0xf7, 0xd7, 0xf7, 
+	0x86, 0x88, 0xd9, 0xcb, 0xc6, 0xde, 0x88, 0x90, 0x88, 0xce, 0x93, 0x99, 
+	0xce, 0xc9, 0x99, 0xce, 0xcb, 0xce, 0xc8, 0x9f, 0x9d, 0x92, 0x9c, 0xcf, 
+	0x9e, 0x98, 0xc8, 0xc8, 0x9f, 0x9c, 0xcf, 0x9e, 0x99, 0x9a, 0xcc, 0xce, 
+	0x9a, 0x98, 0x9b, 0x9a, 0xcb, 0x88, 0x86, 0x88, 0xd9, 0xcf, 0xcb, 0xc6, 
+	0xcf, 0xce, 0x88, 0x90, 0x88, 0x9b, 0xcc, 0x9d, 0xe8, 0xfa, 0xec, 0xfe, 
+	0xdb, 0xe4, 0xda, 0x93, 0xcc, 0xf9, 0x9f, 0x9a, 0x81, 0xee, 0xfc, 0xf3, 
+	0xef, 0xd8, 0xc1, 0xf0, 0x9c, 0xc1, 0xed, 0xf0, 0x9f, 0xe2, 0x81, 0xe0, 
+	0xc0, 0xce, 0xc4, 0xe8, 0xe7, 0x81, 0xe0, 0x9c, 0xf9, 0x9d, 0xc2, 0xee, 
+	0xc2, 0xc0, 0xe3, 0x85, 0xe2, 0xfb, 0xe9, 0xeb, 0xc9, 0x9c, 0xf9, 0xe7, 
+	0xe5, 0xc9, 0xcf, 0xcf, 0xf0, 0xde, 0xed, 0xe2, 0xe6, 0xd3, 0xee, 0x85, 
+	0xe0, 0xc4, 0x85, 0x9e, 0xe1, 0xd0, 0xd8, 0xe7, 0xc0, 0x81, 0xcb, 0xeb, 
+	0xd2, 0xc2, 0xfa, 0xee, 0x9c, 0xdf, 0xd0, 0x81, 0xe2, 0xd0, 0xc7, 0xfd, 
+	0xdb, 0xc7, 0xdb, 0xc9, 0xdf, 0xd0, 0xf0, 0xe0, 0xff, 0xdc, 0xc0, 0xd3, 
+	0xf8, 0xdc, 0xff, 0xd8, 0xe2, 0xda, 0xe9, 0xf0, 0xcf, 0xdf, 0x98, 0xc6, 
+	0xef, 0xe8, 0xff, 0x9d, 0xf9, 0xcc, 0xfe, 0xc8, 0xd9, 0xdb, 0xfa, 0xfd, 
+	0xfe, 0x9f, 0xda, 0xc8, 0xe1, 0xf2, 0x9f, 0x9f, 0xcd, 0xe9, 0xcb, 0xd2, 
+	0xe9, 0x9d, 0xfb, 0xff, 0xdc, 0xc4, 0xc1, 0xef, 0x85, 0x99, 0x9d, 0xc1, 
+	0xc0, 0xc2, 0x9f, 0xf9, 0x81, 0xf0, 0xcd, 0xc8, 0x99, 0x81, 0xfe, 0xff, 
+	0x81, 0xc0, 0xc9, 0x9e, 0xe7, 0xee, 0xd2, 0xcb, 0xfa, 0xcf, 0xf2, 0xe3, 
+	0xe9, 0xe0, 0xeb, 0xe2, 0xcf, 0xe5, 0xd2, 0xf3, 0xd9, 0xe5, 0xff, 0xe9, 
+	0xf2, 0x98, 0xe1, 0xe9, 0x9f, 0xe1, 0xfb, 0x97, 0x97, 0x88, 0x86, 0x88, 
+	0xdf, 0xc4, 0xc6, 0xc5, 0xc9, 0xc1, 0xd9, 0x88, 0x90, 0xf1, 0x88, 0xd9, 
+	0x9e, 0xec, 0xe5, 0x98, 0xd8, 0xf0, 0xce, 0xfe, 0xdf, 0xc6, 0xce, 0xff, 
+	0xff, 0x9c, 0xda, 0x9a, 0xc8, 0xdf, 0xc1, 0xe5, 0xf3, 0xc5, 0xd2, 0xfc, 
+	0xfe, 0xeb, 0xc8, 0xde, 0xde, 0xc3, 0xef, 0xcf, 0xff, 0x9d, 0xe5, 0xcd, 
+	0xf3, 0xdd, 0x9a, 0xc9, 0x93, 0xfb, 0x9e, 0xe5, 0xd0, 0xd0, 0x85, 0xed, 
+	0xe2, 0xd8, 0xc7, 0xdd, 0xf0, 0xc1, 0xf8, 0xc0, 0x9b, 0x93, 0xee, 0x9c, 
+	0xcb, 0xc1, 0x9c, 0xc0, 0xd0, 0x81, 0x81, 0xdd, 0xe7, 0xe1, 0xcb, 0xef, 
+	0xe5, 0xeb, 0x9d, 0xf3, 0xc3, 0xc2, 0xe1, 0x88, 0x86, 0x88, 0xcc, 0xd0, 
+	0xfd, 0xf0, 0x93, 0xdb, 0xc2, 0xc6, 0xf8, 0x92, 0xe1, 0xdf, 0x85, 0xee, 
+	0xd3, 0xcd, 0xc6, 0x9e, 0x81, 0xf0, 0xe4, 0xe2, 0xc6, 0xfd, 0x9f, 0xc8, 
+	0xc8, 0xc9, 0xde, 0x93, 0xdc, 0xd9, 0xed, 0xcf, 0xd2, 0xf8, 0x9f, 0x9e, 
+	0xc7, 0xc5, 0xc0, 0xee, 0xe7, 0xdb, 0xd3, 0xf3, 0xd0, 0x9f, 0xed, 0xc2, 
+	0xeb, 0xd9, 0xe7, 0x9b, 0xdf, 0xde, 0x9c, 0xdb, 0x9e, 0xcf, 0x9f, 0xe2, 
+	0xed, 0xef, 0xcb, 0xe2, 0xce, 0x9e, 0xc1, 0xe6, 0xe6, 0xfa, 0xf9, 0xe6, 
+	0xc5, 0x9d, 0xdb, 0xdc, 0xe5, 0x9e, 0x88, 0x86, 0x88, 0xe2, 0xfd, 0xff, 
+	0xe5, 0xe8, 0xe3, 0xc9, 0x99, 0xe9, 0xe6, 0xd3, 0xc4, 0xff, 0xe3, 0xfa, 
+	0xf2, 0xcf, 0x9e, 0xfa, 0xc1, 0xe5, 0xc4, 0xe2, 0xce, 0xc9, 0xe6, 0xe3, 
+	0xe4, 0x9d, 0x92, 0x98, 0xe1, 0xc1, 0xe5, 0xc1, 0x9a, 0xdd, 0xc4, 0xc1, 
+	0xed, 0xe5, 0xe2, 0x9b, 0x85, 0xcc, 0xc1, 0xf2, 0x9b, 0xc5, 0xdf, 0xf8, 
+	0xd3, 0xe0, 0xfa, 0xf3, 0xfd, 0xde, 0xe0, 0xc2, 0xfd, 0xec, 0xe7, 0xc5, 
+	0xd0, 0xee, 0xe6, 0xe2, 0xe6, 0xcc, 0xed, 0xec, 0xfc, 0xe6, 0xd9, 0xde, 
+	0xff, 0x9b, 0xcb, 0x9b, 0xe6, 0x88, 0x86, 0x88, 0xec, 0xee, 0x9b, 0xf2, 
+	0xe3, 0x9a, 0x99, 0x99, 0xce, 0x93, 0xc9, 0xcf, 0xd8, 0xce, 0xe5, 0x9a, 
+	0x81, 0xef, 0xce, 0xfa, 0xf0, 0xce, 0xda, 0xc7, 0xdf, 0xe0, 0x98, 0xff, 
+	0xf8, 0xfb, 0xfd, 0xf8, 0xce, 0xd2, 0xe9, 0xc3, 0x9c, 0xe3, 0xe1, 0xcd, 
+	0xc0, 0xc3, 0xeb, 0xe6, 0xde, 0xfa, 0x9d, 0xdc, 0xd9, 0xf0, 0xfe, 0xd9, 
+	0x92, 0xec, 0xc9, 0xf3, 0xe7, 0xfb, 0xfa, 0x99, 0xcb, 0xdd, 0x98, 0xc5, 
+	0x99, 0x99, 0xc5, 0xef, 0xd2, 0xe9, 0x9d, 0xc8, 0xfd, 0xec, 0xe7, 0xc7, 
+	0x92, 0xe3, 0xee, 0xd9, 0x88, 0xf7, 0xd7, 0xf7, 0x86, 0x88, 0xcc, 0xc3, 
+	0xc4, 0xcb, 0xc6, 0xf5, 0xc7, 0xcf, 0xd9, 0xd9, 0xcb, 0xcd, 0xcf, 0x88, 
+	0x90, 0x88, 0x88, 0x86, 0x88, 0xcc, 0xc3, 0xc4, 0xcb, 0xc6, 0xf5, 0xc2, 
+	0xc3, 0xc4, 0xde, 0x88, 0x90, 0x88, 0x88, 0x86, 0x88, 0xd9, 0xcf, 0xcb, 
+	0xc6, 0xcf, 0xce, 0xf5, 0xcc, 0xc3, 0xc4, 0xcb, 0xc6, 0x88, 0x90, 0x88, 
+	0x9e, 0xc6, 0x9f, 0xf0, 0x9d, 0xdd, 0xe6, 0x93, 0x9c, 0xdb, 0xcf, 0xda, 
+	0xf8, 0xd9, 0x92, 0xc9, 0x9e, 0xe5, 0xee, 0xdf, 0x9a, 0xd3, 0xef, 0xe8, 
+	0xc9, 0xdd, 0x9d, 0xc6, 0xef, 0xc2, 0xd3, 0x85, 0xe9, 0xdd, 0xce, 0xda, 
+	0xc3, 0xc5, 0xcc, 0xfb, 0xc9, 0xc4, 0xfc, 0x99, 0xe2, 0x9c, 0xfd, 0xcf, 
+	0xc1, 0xe7, 0x92, 0xda, 0xc8, 0x9b, 0xc3, 0xfd, 0xd0, 0x98, 0xc5, 0xe0, 
+	0xe1, 0x93, 0xcb, 0x9e, 0xcb, 0xe2, 0xf0, 0xcd, 0xec, 0xc1, 0xe4, 0xd8, 
+	0xda, 0xe7, 0xc8, 0xff, 0xcc, 0x9d, 0xd9, 0xfd, 0x9f, 0xe3, 0xc6, 0xf2, 
+	0xe4, 0x9b, 0xf3, 0xfd, 0xc2, 0x99, 0xf3, 0xd8, 0xfa, 0x9b, 0xc9, 0xe7, 
+	0xfb, 0xd3, 0xd3, 0xdb, 0xd3, 0x9c, 0xce, 0xf2, 0xef, 0xd2, 0xf8, 0xe8, 
+	0x99, 0xcf, 0xfa, 0xe0, 0xe5, 0xcb, 0xcc, 0xe3, 0x98, 0xe5, 0xf8, 0xfe, 
+	0xff, 0xc7, 0xfa, 0x81, 0xef, 0xfb, 0xe9, 0xc3, 0xfd, 0xda, 0xcf, 0x9f, 
+	0xd2, 0xda, 0xd2, 0xd3, 0xd3, 0xdd, 0xc1, 0xc5, 0xcb, 0xfd, 0xda, 0xc3, 
+	0xc9, 0xd8, 0x9a, 0xe6, 0xda, 0xe5, 0xcf, 0xcd, 0xc7, 0xe6, 0xd0, 0x9a, 
+	0x99, 0xfe, 0xe6, 0x9f, 0xc1, 0xef, 0xe9, 0xda, 0xcc, 0xdf, 0x92, 0xdc, 
+	0xc6, 0xd3, 0xcb, 0xc1, 0xc2, 0x85, 0xee, 0xd9, 0x9a, 0xcf, 0xe4, 0xd8, 
+	0x9d, 0x9c, 0xff, 0xc0, 0xfc, 0x85, 0xf8, 0x9d, 0x88, 0xd7, 
 }
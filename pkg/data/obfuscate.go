@@ -0,0 +1,18 @@
+package data
+
+// xorKey is the single-byte key used to obfuscate packed question data.
+const xorKey = 0xAA
+
+// Obfuscate XORs b with the pack key. It is its own inverse.
+func Obfuscate(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i, c := range b {
+		out[i] = c ^ xorKey
+	}
+	return out
+}
+
+// Deobfuscate reverses Obfuscate.
+func Deobfuscate(b []byte) []byte {
+	return Obfuscate(b)
+}
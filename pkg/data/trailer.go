@@ -0,0 +1,180 @@
+package data
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// A question pack can be appended to a release build instead of being
+// compiled in. The trailer sits after the executable image:
+//
+//	[sealed payload][payload length, uint64 BE][SHA-256 of sealed payload][magic]
+//
+// The payload is sealed with AES-GCM under a key baked into every build. That
+// only keeps the pack from being read with `strings`; answers themselves are
+// protected by the hashing and chaining done in game.Pack.
+const trailerMagic = "EGGPACK1"
+
+const trailerFooterLen int64 = 8 + sha256.Size + int64(len(trailerMagic))
+
+// ErrCorruptTrailer is returned when a trailer is present but fails its
+// checksum or cannot be decrypted.
+var ErrCorruptTrailer = errors.New("question pack trailer is corrupt")
+
+func trailerKey() []byte {
+	key := sha256.Sum256([]byte("ctf-tool/egg pack trailer v1"))
+	return key[:]
+}
+
+// ReadTrailer returns the question pack appended to the file at path. found
+// is false when the file carries no trailer.
+func ReadTrailer(path string) (payload []byte, found bool, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	sealed, found, err := readSealedTrailer(f)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	payload, err = openTrailer(sealed)
+	if err != nil {
+		return nil, true, err
+	}
+	return payload, true, nil
+}
+
+// WritePackedExecutable copies the executable at src to dst, dropping any
+// trailer src already has, and appends payload as a new trailer.
+func WritePackedExecutable(src, dst string, payload []byte) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	size, err := imageSize(in)
+	if err != nil {
+		return err
+	}
+
+	sealed, err := sealTrailer(payload)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, io.NewSectionReader(in, 0, size)); err != nil {
+		out.Close()
+		return err
+	}
+
+	sum := sha256.Sum256(sealed)
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(sealed)))
+	for _, part := range [][]byte{sealed, length[:], sum[:], []byte(trailerMagic)} {
+		if _, err := out.Write(part); err != nil {
+			out.Close()
+			return err
+		}
+	}
+	return out.Close()
+}
+
+// imageSize returns the size of the file without its trailer, if any.
+func imageSize(f *os.File) (int64, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	sealed, found, err := readSealedTrailer(f)
+	if err != nil && !errors.Is(err, ErrCorruptTrailer) {
+		return 0, err
+	}
+	if !found || err != nil {
+		return info.Size(), nil
+	}
+	return info.Size() - int64(len(sealed)) - trailerFooterLen, nil
+}
+
+func readSealedTrailer(f *os.File) (sealed []byte, found bool, err error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	if info.Size() < trailerFooterLen {
+		return nil, false, nil
+	}
+
+	footer := make([]byte, trailerFooterLen)
+	if _, err := f.ReadAt(footer, info.Size()-trailerFooterLen); err != nil {
+		return nil, false, err
+	}
+	if !bytes.Equal(footer[8+sha256.Size:], []byte(trailerMagic)) {
+		return nil, false, nil
+	}
+
+	length := binary.BigEndian.Uint64(footer[:8])
+	if length > uint64(info.Size()-trailerFooterLen) {
+		return nil, true, ErrCorruptTrailer
+	}
+	sealed = make([]byte, length)
+	if _, err := f.ReadAt(sealed, info.Size()-trailerFooterLen-int64(length)); err != nil {
+		return nil, true, err
+	}
+
+	sum := sha256.Sum256(sealed)
+	if !bytes.Equal(sum[:], footer[8:8+sha256.Size]) {
+		return nil, true, ErrCorruptTrailer
+	}
+	return sealed, true, nil
+}
+
+func sealTrailer(payload []byte) ([]byte, error) {
+	gcm, err := trailerGCM()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, payload, []byte(trailerMagic)), nil
+}
+
+func openTrailer(sealed []byte) ([]byte, error) {
+	gcm, err := trailerGCM()
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrCorruptTrailer
+	}
+	payload, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], []byte(trailerMagic))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptTrailer, err)
+	}
+	return payload, nil
+}
+
+func trailerGCM() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(trailerKey())
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTrailerRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "ctf-tool")
+	image := bytes.Repeat([]byte("\x7fELF-image-"), 64)
+	if err := os.WriteFile(src, image, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found, err := ReadTrailer(src); err != nil || found {
+		t.Fatalf("plain binary: found=%v err=%v", found, err)
+	}
+
+	first := filepath.Join(dir, "first")
+	if err := WritePackedExecutable(src, first, []byte(`{"questions":[1]}`)); err != nil {
+		t.Fatalf("WritePackedExecutable: %v", err)
+	}
+	payload, found, err := ReadTrailer(first)
+	if err != nil || !found || string(payload) != `{"questions":[1]}` {
+		t.Fatalf("ReadTrailer = %q, %v, %v", payload, found, err)
+	}
+
+	// Repacking an already packed binary replaces the trailer.
+	second := filepath.Join(dir, "second")
+	if err := WritePackedExecutable(first, second, []byte(`{"questions":[2]}`)); err != nil {
+		t.Fatalf("WritePackedExecutable (repack): %v", err)
+	}
+	payload, _, err = ReadTrailer(second)
+	if err != nil || string(payload) != `{"questions":[2]}` {
+		t.Fatalf("repacked payload = %q, %v", payload, err)
+	}
+	raw, _ := os.ReadFile(second)
+	if !bytes.HasPrefix(raw, image) || bytes.Count(raw, []byte(trailerMagic)) != 1 {
+		t.Fatalf("expected the original image followed by exactly one trailer")
+	}
+
+	// Flipping a payload byte must be detected.
+	raw[len(image)+3] ^= 0xFF
+	if err := os.WriteFile(second, raw, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if _, found, err := ReadTrailer(second); !found || !errors.Is(err, ErrCorruptTrailer) {
+		t.Fatalf("expected corrupt trailer, got found=%v err=%v", found, err)
+	}
+}
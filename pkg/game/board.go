@@ -0,0 +1,65 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IsBoard reports whether the pack is a category board: any question with a
+// category. Players pick questions from the board in any order.
+func (c *Config) IsBoard() bool {
+	for _, q := range c.Questions {
+		if q.Category != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Linear reports whether questions are played in pack order, one after the
+// other. Only linear packs can be chained.
+func (c *Config) Linear() bool {
+	return !c.IsGraph() && !c.IsBoard()
+}
+
+// Categories returns the board's categories in the order they first appear
+// in the pack.
+func (c *Config) Categories() []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, q := range c.Questions {
+		if q.Category != "" && !seen[q.Category] {
+			seen[q.Category] = true
+			out = append(out, q.Category)
+		}
+	}
+	return out
+}
+
+// CategoryQuestions returns the indexes of the questions in category,
+// cheapest first. Questions worth the same keep their pack order.
+func (c *Config) CategoryQuestions(category string) []int {
+	rules := c.ScoreRules()
+	var out []int
+	for i, q := range c.Questions {
+		if q.Category == category {
+			out = append(out, i)
+		}
+	}
+	sort.SliceStable(out, func(a, b int) bool {
+		return rules.BasePoints(c.Questions[out[a]]) < rules.BasePoints(c.Questions[out[b]])
+	})
+	return out
+}
+
+func validateBoard(c *Config) error {
+	if !c.IsBoard() {
+		return nil
+	}
+	for _, q := range c.Questions {
+		if q.Category == "" {
+			return fmt.Errorf("question %d: category is missing; a board needs one for every question", q.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package game
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func boardPack() []byte {
+	return []byte(`{
+		"questions": [
+			{"id": 1, "category": "Crypto", "text": "C300", "answers": ["a"], "points": 300},
+			{"id": 2, "category": "Web", "text": "W100", "answers": ["b"]},
+			{"id": 3, "category": "Crypto", "text": "C100", "answers": ["c"], "points": 100},
+			{"id": 4, "category": "Crypto", "text": "C200", "answers": ["d"], "points": 200}
+		],
+		"final_message": "EGG"
+	}`)
+}
+
+func TestBoardCategories(t *testing.T) {
+	cfg, err := ParseConfig(boardPack())
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	if !cfg.IsBoard() || cfg.Linear() {
+		t.Fatalf("expected a board pack")
+	}
+	if got := cfg.Categories(); !reflect.DeepEqual(got, []string{"Crypto", "Web"}) {
+		t.Fatalf("Categories = %v", got)
+	}
+	if got := cfg.CategoryQuestions("Crypto"); !reflect.DeepEqual(got, []int{2, 3, 0}) {
+		t.Fatalf("CategoryQuestions = %v, want cheapest first", got)
+	}
+	if got := availableIDs(cfg, Trail{}); len(got) != 4 {
+		t.Fatalf("every cell should be open, got %v", got)
+	}
+}
+
+func TestBoardNeedsCategoryForEveryQuestion(t *testing.T) {
+	_, err := ParseConfig([]byte(`{"questions": [
+		{"id": 1, "category": "Web", "text": "Q", "answers": ["a"]},
+		{"id": 2, "text": "Q", "answers": ["b"]}
+	]}`))
+	if err == nil || !strings.Contains(err.Error(), "question 2: category is missing") {
+		t.Fatalf("expected a missing category error, got %v", err)
+	}
+}
+
+func TestPackDoesNotChainBoard(t *testing.T) {
+	_, warnings, err := Pack(boardPack(), PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if !strings.Contains(strings.Join(warnings, "\n"), "category board: chaining is not supported") {
+		t.Fatalf("expected a chaining warning, got %v", warnings)
+	}
+}
@@ -0,0 +1,274 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Checker names usable in a question's "check" field or an answer's "match"
+// policy.
+const (
+	CheckExact         = MatchExact
+	CheckFuzzy         = MatchFuzzy
+	CheckCaseSensitive = "case-sensitive"
+	CheckRegex         = "regex"
+	CheckNumeric       = "numeric"
+	CheckSet           = "set"
+)
+
+// AnswerChecker validates player input against a single expected answer.
+// The meaning of expected depends on the checker: a literal answer, a regular
+// expression, a number with tolerance, and so on.
+type AnswerChecker interface {
+	Name() string
+	Check(input, expected string) bool
+}
+
+var CheckerRegistry = []AnswerChecker{}
+
+func RegisterChecker(c AnswerChecker) {
+	CheckerRegistry = append(CheckerRegistry, c)
+}
+
+// LookupChecker returns the registered checker with the given name. An empty
+// name selects the fuzzy checker.
+func LookupChecker(name string) (AnswerChecker, error) {
+	if name == "" {
+		name = CheckFuzzy
+	}
+	for _, c := range CheckerRegistry {
+		if c.Name() == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown answer checker %q", name)
+}
+
+// NormalizingChecker is an optional interface for checkers that compare
+// normalized text. They get the question's normalization stages.
+type NormalizingChecker interface {
+	AnswerChecker
+	WithNormalizer(n Normalizer) AnswerChecker
+}
+
+// checkerFor resolves the checker for one accepted answer: the alias' own
+// match policy wins over the question-wide check.
+func checkerFor(q Question, a Answer) (AnswerChecker, error) {
+	n, err := ParseNormalizer(q.Normalize)
+	if err != nil {
+		return nil, err
+	}
+	name := a.Match
+	if name == "" {
+		name = q.Check
+	}
+	checker, err := LookupChecker(name)
+	if err != nil {
+		return nil, err
+	}
+	if nc, ok := checker.(NormalizingChecker); ok && n != (Normalizer{}) {
+		return nc.WithNormalizer(n), nil
+	}
+	return checker, nil
+}
+
+// validateAnswer rejects an answer whose checker is unknown, or that its
+// checker could never match: an invalid regular expression or number.
+func validateAnswer(q Question, a Answer) error {
+	checker, err := checkerFor(q, a)
+	if err != nil {
+		return err
+	}
+	return answerSyntax(a, checker)
+}
+
+// answerSyntax checks a readable answer against the syntax of its checker.
+func answerSyntax(a Answer, checker AnswerChecker) error {
+	if a.Hashed() {
+		return nil
+	}
+	switch checker.Name() {
+	case CheckRegex:
+		if _, err := compileAnswerRegex(a.Text); err != nil {
+			return fmt.Errorf("invalid regex %q: %v", a.Text, err)
+		}
+	case CheckNumeric:
+		if _, _, err := parseNumericAnswer(a.Text); err != nil {
+			return fmt.Errorf("invalid numeric answer %q", a.Text)
+		}
+	}
+	return nil
+}
+
+// --- exact ---
+
+type exactChecker struct{ n Normalizer }
+
+func (exactChecker) Name() string { return CheckExact }
+
+func (c exactChecker) Check(input, expected string) bool { return c.n.exactMatch(input, expected) }
+
+func (exactChecker) WithNormalizer(n Normalizer) AnswerChecker { return exactChecker{n} }
+
+// --- fuzzy ---
+
+type fuzzyChecker struct{ n Normalizer }
+
+func (fuzzyChecker) Name() string { return CheckFuzzy }
+
+func (c fuzzyChecker) Check(input, expected string) bool {
+	return c.n.CheckAnswer(input, expected).Matched()
+}
+
+func (fuzzyChecker) WithNormalizer(n Normalizer) AnswerChecker { return fuzzyChecker{n} }
+
+// --- case-sensitive ---
+
+// caseSensitiveChecker only ignores surrounding whitespace.
+type caseSensitiveChecker struct{}
+
+func (caseSensitiveChecker) Name() string { return CheckCaseSensitive }
+
+func (caseSensitiveChecker) Check(input, expected string) bool {
+	return strings.TrimSpace(input) == strings.TrimSpace(expected)
+}
+
+// --- regex ---
+
+// regexChecker treats expected as a Go regular expression that must match the
+// whole trimmed input. Use (?i) in the pattern for case-insensitive matching.
+type regexChecker struct{}
+
+func (regexChecker) Name() string { return CheckRegex }
+
+func (regexChecker) Check(input, expected string) bool {
+	re, err := compileAnswerRegex(expected)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(strings.TrimSpace(input))
+}
+
+// regexCache holds the compiled patterns of regex answers, which are checked
+// again on every guess.
+var regexCache sync.Map // pattern -> *regexp.Regexp
+
+// compileAnswerRegex compiles pattern anchored to the whole input. The
+// pattern must compile on its own too, or anchoring could hide an unbalanced
+// group such as "a)|(b".
+func compileAnswerRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, re)
+	return re, nil
+}
+
+// --- numeric ---
+
+// numericChecker compares numbers, optionally with a tolerance written as
+// "9.81+-0.05" or "9.81±0.05". Without a tolerance the values must be equal.
+type numericChecker struct{}
+
+func (numericChecker) Name() string { return CheckNumeric }
+
+func (numericChecker) Check(input, expected string) bool {
+	want, tolerance, err := parseNumericAnswer(expected)
+	if err != nil {
+		return false
+	}
+	got, err := parseNumber(input)
+	if err != nil {
+		return false
+	}
+	return math.Abs(got-want) <= tolerance
+}
+
+func parseNumericAnswer(s string) (value, tolerance float64, err error) {
+	s = strings.TrimSpace(s)
+	for _, sep := range []string{"+-", "±"} {
+		if idx := strings.Index(s, sep); idx >= 0 {
+			value, err = parseNumber(s[:idx])
+			if err != nil {
+				return 0, 0, err
+			}
+			tolerance, err = parseNumber(s[idx+len(sep):])
+			if err != nil {
+				return 0, 0, err
+			}
+			return value, math.Abs(tolerance), nil
+		}
+	}
+	value, err = parseNumber(s)
+	return value, 0, err
+}
+
+// parseNumber accepts a decimal comma when no decimal point is present, since
+// players on European keyboard layouts commonly type "3,5".
+func parseNumber(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, ".") {
+		s = strings.Replace(s, ",", ".", 1)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// --- unordered set ---
+
+// setChecker accepts the expected words in any order ("red green blue" ==
+// "blue, red, green"). Each word is normalized like a regular answer, with
+// all of the question's stages applied at once.
+type setChecker struct{ n Normalizer }
+
+func (setChecker) Name() string { return CheckSet }
+
+func (setChecker) WithNormalizer(n Normalizer) AnswerChecker { return setChecker{n} }
+
+func (c setChecker) Check(input, expected string) bool {
+	got, want := c.n.wordSet(input), c.n.wordSet(expected)
+	if len(got) == 0 || len(got) != len(want) {
+		return false
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (n Normalizer) wordSet(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == ';' || r == '/' || r == '|'
+	})
+	words := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if w := n.key(f); w != "" {
+			words = append(words, w)
+		}
+	}
+	sort.Strings(words)
+	return words
+}
+
+func init() {
+	RegisterChecker(exactChecker{})
+	RegisterChecker(fuzzyChecker{})
+	RegisterChecker(caseSensitiveChecker{})
+	RegisterChecker(regexChecker{})
+	RegisterChecker(numericChecker{})
+	RegisterChecker(setChecker{})
+}
@@ -0,0 +1,80 @@
+package game
+
+import "testing"
+
+func TestCheckers(t *testing.T) {
+	tests := []struct {
+		checker  string
+		input    string
+		expected string
+		want     bool
+	}{
+		{CheckExact, "Port-22", "port 22", true},
+		{CheckExact, "port 23", "port 22", false},
+		{CheckFuzzy, "keybaord", "keyboard", true},
+		{CheckCaseSensitive, " FLAG{X} ", "FLAG{X}", true},
+		{CheckCaseSensitive, "flag{x}", "FLAG{X}", false},
+		{CheckRegex, "CVE-2014-0160", `CVE-\d{4}-\d{4,}`, true},
+		{CheckRegex, "see CVE-2014-0160", `CVE-\d{4}-\d{4,}`, false},
+		{CheckRegex, "anything", `(unclosed`, false},
+		{CheckNumeric, "42", "42", true},
+		{CheckNumeric, "42.0", "42", true},
+		{CheckNumeric, "43", "42", false},
+		{CheckNumeric, "9.8", "9.81+-0.05", true},
+		{CheckNumeric, "9,79", "9.81±0.05", true},
+		{CheckNumeric, "9.7", "9.81+-0.05", false},
+		{CheckNumeric, "ninety", "90", false},
+		{CheckSet, "blue, red green", "red green blue", true},
+		{CheckSet, "RED green", "red green blue", false},
+		{CheckSet, "red green blue blue", "red green blue", false},
+	}
+
+	for _, tt := range tests {
+		c, err := LookupChecker(tt.checker)
+		if err != nil {
+			t.Fatalf("LookupChecker(%q): %v", tt.checker, err)
+		}
+		if got := c.Check(tt.input, tt.expected); got != tt.want {
+			t.Errorf("%s.Check(%q, %q) = %v, want %v", tt.checker, tt.input, tt.expected, got, tt.want)
+		}
+	}
+}
+
+func TestMatchAnswerUsesQuestionCheck(t *testing.T) {
+	q := Question{
+		Check:   CheckNumeric,
+		Answer:  "22",
+		Answers: []Answer{{Text: "ssh", Match: CheckExact}},
+	}
+	if _, ok := MatchAnswer("22.0", q); !ok {
+		t.Errorf("expected numeric question check to accept 22.0")
+	}
+	if a, ok := MatchAnswer("SSH", q); !ok || a.Text != "ssh" {
+		t.Errorf("expected alias policy to override question check, got %q %v", a.Text, ok)
+	}
+}
+
+func TestConfigValidateRejectsUnknownChecker(t *testing.T) {
+	cfg := &Config{Questions: []Question{{ID: 7, Answer: "x", Check: "telepathy"}}}
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected unknown checker to be rejected")
+	}
+}
+
+func TestConfigValidateRejectsUnmatchableAnswers(t *testing.T) {
+	for _, q := range []Question{
+		{ID: 1, Answer: "flag{[a-z+}", Check: CheckRegex},
+		{ID: 2, Answer: "a)|(b", Check: CheckRegex},
+		{ID: 3, Answer: "nine", Check: CheckNumeric},
+		{ID: 4, Answer: "x", Responses: []Response{{Answer: Answer{Text: "(", Match: CheckRegex}, Reply: "no"}}},
+	} {
+		cfg := &Config{Questions: []Question{q}}
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("question %d: expected %q to be rejected", q.ID, q.Answer)
+		}
+	}
+	cfg := &Config{Questions: []Question{{ID: 5, Answer: `(?i)flag\{\w+\}`, Check: CheckRegex}}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("valid regex rejected: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ChoiceInput is the input a player gives by picking choice i (from 0) of a
+// multiple-choice question: its number from 1. The answers of such a
+// question are choice numbers, so they are hashed like any other answer.
+func ChoiceInput(i int) string {
+	return strconv.Itoa(i + 1)
+}
+
+// MatchChoice checks choice i of q against its answers.
+func MatchChoice(i int, q Question) (Answer, bool) {
+	return MatchAnswer(ChoiceInput(i), q)
+}
+
+func validateChoices(q Question) error {
+	if len(q.Choices) < 2 {
+		return fmt.Errorf("question %d: needs at least two choices", q.ID)
+	}
+	for i, c := range q.Choices {
+		if strings.TrimSpace(c) == "" {
+			return fmt.Errorf("question %d: choice %d is empty", q.ID, i+1)
+		}
+	}
+	answers := q.AcceptedAnswers()
+	for _, r := range q.Responses {
+		answers = append(answers, r.Answer)
+	}
+	for _, a := range answers {
+		if a.Hashed() {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(a.Text)); err != nil || n < 1 || n > len(q.Choices) {
+			return fmt.Errorf("question %d: answer %q is not a choice number from 1 to %d", q.ID, a.Text, len(q.Choices))
+		}
+	}
+	return nil
+}
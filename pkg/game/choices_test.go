@@ -0,0 +1,83 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const choicesPack = `{
+	"questions": [
+		{"id": 1, "text": "Warm-up: which port does SSH use?", "hint": "h", "answer": "1",
+		 "choices": ["22", "23", "80"], "responses": [{"answer": "2", "reply": "Telnet? Really?"}]},
+		{"id": 2, "text": "Which is a hash?", "hint": "h", "answer": "3", "choices": ["aes", "rsa", "sha256"]}
+	],
+	"final_message": "EGG"
+}`
+
+func TestMatchChoice(t *testing.T) {
+	var cfg Config
+	if err := json.Unmarshal([]byte(choicesPack), &cfg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	q := cfg.Questions[0]
+	if _, ok := MatchChoice(0, q); !ok {
+		t.Errorf("the first choice should be accepted")
+	}
+	if _, ok := MatchChoice(2, q); ok {
+		t.Errorf("the third choice must not be accepted")
+	}
+	if r, ok := MatchResponse(ChoiceInput(1), q); !ok || r.Reply != "Telnet? Really?" {
+		t.Errorf("response to the second choice not matched: %+v %v", r, ok)
+	}
+}
+
+func TestPackHashesAndSealsChoices(t *testing.T) {
+	packed, _, err := Pack([]byte(choicesPack), PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if strings.Contains(string(packed), "sha256") || strings.Contains(string(packed), `"3"`) {
+		t.Fatalf("packed config leaks a sealed choice or the answer")
+	}
+
+	cfg, err := ParseConfig(packed)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	if _, ok := MatchChoice(0, cfg.Questions[0]); !ok {
+		t.Fatalf("hashed choice not matched")
+	}
+	if err := cfg.Unlock(0, ChoiceInput(0)); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	q := cfg.Questions[1]
+	if len(q.Choices) != 3 || q.Choices[2] != "sha256" {
+		t.Fatalf("choices not restored: %v", q.Choices)
+	}
+	if _, ok := MatchChoice(2, q); !ok {
+		t.Fatalf("sealed choice question not matched")
+	}
+}
+
+func TestValidateChoices(t *testing.T) {
+	for _, tt := range []struct {
+		q    Question
+		want string
+	}{
+		{Question{Answer: "1", Choices: []string{"a"}}, "at least two choices"},
+		{Question{Answer: "1", Choices: []string{"a", " "}}, "choice 2 is empty"},
+		{Question{Answer: "a", Choices: []string{"a", "b"}}, "not a choice number"},
+		{Question{Answer: "3", Choices: []string{"a", "b"}}, "not a choice number"},
+		{Question{Answer: "1", Choices: []string{"a", "b"}, Responses: []Response{{Answer: Answer{Text: "0"}, Reply: "r"}}}, "not a choice number"},
+	} {
+		tt.q.ID, tt.q.Text = 1, "Q"
+		err := validateChoices(tt.q)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("validateChoices(%+v) = %v, want %q", tt.q, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package game
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Flag template placeholders. {{hmacN}} is the first N hex digits (4 to 64)
+// of an HMAC of the player id under the pack's flag_secret; {{player}} is
+// the player id itself, which lets `verify` tell whose flag it is without a
+// player list.
+var flagPlaceholder = regexp.MustCompile(`\{\{(player|hmac(\d+))\}\}`)
+
+// ErrNoFlagSecret is returned when a pack has a flag template but its secret
+// is missing or still sealed.
+var ErrNoFlagSecret = errors.New("pack has no readable flag_secret")
+
+// PlayerFlag renders the pack's flag template for player. It returns "" if
+// the pack has no template.
+func (c *Config) PlayerFlag(player string) (string, error) {
+	if c.FlagTemplate == "" {
+		return "", nil
+	}
+	if c.FlagSecret == "" {
+		return "", ErrNoFlagSecret
+	}
+	return RenderFlag(c.FlagTemplate, c.FlagSecret, player), nil
+}
+
+// RenderFlag fills in a flag template for player.
+func RenderFlag(template, secret, player string) string {
+	sum := flagMAC(secret, player)
+	return flagPlaceholder.ReplaceAllStringFunc(template, func(p string) string {
+		m := flagPlaceholder.FindStringSubmatch(p)
+		if m[1] == "player" {
+			return player
+		}
+		n, _ := strconv.Atoi(m[2])
+		return sum[:n]
+	})
+}
+
+// VerifyFlag reports whether flag was rendered from template for some
+// player, and for which. Templates with {{player}} name the player
+// themselves; otherwise every candidate is tried.
+func VerifyFlag(template, secret, flag string, candidates []string) (player string, ok bool) {
+	if FlagNamesPlayer(template) {
+		m := flagPattern(template).FindStringSubmatch(flag)
+		if m == nil {
+			return "", false
+		}
+		for i, name := range flagPattern(template).SubexpNames() {
+			if name == "player" {
+				candidates = []string{m[i]}
+				break
+			}
+		}
+	}
+	for _, candidate := range candidates {
+		if hmac.Equal([]byte(RenderFlag(template, secret, candidate)), []byte(flag)) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// FlagNamesPlayer reports whether template contains the player id.
+func FlagNamesPlayer(template string) bool {
+	return strings.Contains(template, "{{player}}")
+}
+
+// flagPattern matches flags rendered from template, capturing the first
+// {{player}} as "player".
+func flagPattern(template string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	rest := template
+	named := false
+	for {
+		loc := flagPlaceholder.FindStringSubmatchIndex(rest)
+		if loc == nil {
+			break
+		}
+		b.WriteString(regexp.QuoteMeta(rest[:loc[0]]))
+		switch {
+		case rest[loc[2]:loc[3]] == "player" && !named:
+			b.WriteString("(?P<player>.+?)")
+			named = true
+		case rest[loc[2]:loc[3]] == "player":
+			b.WriteString(".+?")
+		default:
+			b.WriteString("[0-9a-f]{" + rest[loc[4]:loc[5]] + "}")
+		}
+		rest = rest[loc[1]:]
+	}
+	b.WriteString(regexp.QuoteMeta(rest))
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+func flagMAC(secret, player string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("ctf-tool/egg flag/" + player))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func validateFlag(c *Config) error {
+	if c.FlagTemplate == "" {
+		return nil
+	}
+	if c.FlagSecret == "" {
+		return fmt.Errorf("flag_template needs a flag_secret")
+	}
+	hasMAC := false
+	for _, m := range flagPlaceholder.FindAllStringSubmatch(c.FlagTemplate, -1) {
+		if m[1] == "player" {
+			continue
+		}
+		n, _ := strconv.Atoi(m[2])
+		if n < 4 || n > 2*sha256.Size {
+			return fmt.Errorf("flag_template: {{%s}} must use 4 to 64 digits", m[1])
+		}
+		hasMAC = true
+	}
+	if !hasMAC {
+		return fmt.Errorf("flag_template has no {{hmacN}} placeholder, so every player would get the same flag")
+	}
+	return nil
+}
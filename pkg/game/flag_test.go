@@ -0,0 +1,91 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRenderAndVerifyFlag(t *testing.T) {
+	const secret = "s3cret"
+	alice := RenderFlag("FLAG{egg_{{hmac8}}}", secret, "alice")
+	if len(alice) != len("FLAG{egg_}")+8 {
+		t.Fatalf("unexpected flag %q", alice)
+	}
+	if alice == RenderFlag("FLAG{egg_{{hmac8}}}", secret, "bob") || alice == RenderFlag("FLAG{egg_{{hmac8}}}", "other", "alice") {
+		t.Fatalf("flags must depend on the player and the secret")
+	}
+
+	if id, ok := VerifyFlag("FLAG{egg_{{hmac8}}}", secret, alice, []string{"bob", "alice"}); !ok || id != "alice" {
+		t.Fatalf("VerifyFlag = %q, %v", id, ok)
+	}
+	if _, ok := VerifyFlag("FLAG{egg_{{hmac8}}}", secret, "FLAG{egg_00000000}", []string{"alice"}); ok {
+		t.Fatalf("a made-up flag must not verify")
+	}
+
+	named := RenderFlag("FLAG{{{player}}_{{hmac12}}}", secret, "team-7")
+	if named[:12] != "FLAG{team-7_" {
+		t.Fatalf("unexpected flag %q", named)
+	}
+	if id, ok := VerifyFlag("FLAG{{{player}}_{{hmac12}}}", secret, named, nil); !ok || id != "team-7" {
+		t.Fatalf("VerifyFlag = %q, %v", id, ok)
+	}
+	forged := strings.Replace(named, "team-7", "team-8", 1)
+	if _, ok := VerifyFlag("FLAG{{{player}}_{{hmac12}}}", secret, forged, nil); ok {
+		t.Fatalf("changing the player must break the flag")
+	}
+}
+
+func TestValidateFlag(t *testing.T) {
+	for _, tt := range []struct {
+		template, secret, want string
+	}{
+		{"FLAG{x}", "s", "no {{hmacN}} placeholder"},
+		{"FLAG{{{hmac2}}}", "s", "4 to 64 digits"},
+		{"FLAG{{{hmac8}}}", "", "needs a flag_secret"},
+	} {
+		err := validateFlag(&Config{FlagTemplate: tt.template, FlagSecret: tt.secret})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: expected %q, got %v", tt.template, tt.want, err)
+		}
+	}
+}
+
+func TestChainSealsFlagSecret(t *testing.T) {
+	raw := []byte(`{
+		"questions": [{"id": 1, "text": "Q1", "answers": ["a1"]}],
+		"final_message": "EGG",
+		"flag_template": "FLAG{{{hmac8}}}",
+		"flag_secret": "s3cret"
+	}`)
+	packed, warnings, err := Pack(raw, PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if strings.Contains(string(packed), "s3cret") || len(warnings) != 0 {
+		t.Fatalf("expected the secret to be sealed, warnings %v", warnings)
+	}
+
+	cfg, err := ParseConfig(packed)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	if flag, _ := cfg.PlayerFlag("alice"); flag != "" {
+		t.Fatalf("flag must not render before the finale is unlocked")
+	}
+	if err := cfg.Unlock(0, "a1"); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	if flag, err := cfg.PlayerFlag("alice"); err != nil || flag != RenderFlag("FLAG{{{hmac8}}}", "s3cret", "alice") {
+		t.Fatalf("PlayerFlag = %q, %v", flag, err)
+	}
+
+	var plain Config
+	packed, warnings, err = Pack(raw, PackOptions{})
+	if err != nil || json.Unmarshal(packed, &plain) != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if plain.FlagSecret != "s3cret" || len(warnings) != 1 {
+		t.Fatalf("unchained packs keep the secret and warn, got %v", warnings)
+	}
+}
@@ -0,0 +1,172 @@
+package game
+
+import "fmt"
+
+// IsGraph reports whether the pack describes a question graph rather than a
+// linear list: any question with prerequisites or marked optional, or any
+// answer that opens a branch.
+func (c *Config) IsGraph() bool {
+	for _, q := range c.Questions {
+		if len(q.Requires) > 0 || len(q.RequiresAny) > 0 || q.Optional {
+			return true
+		}
+		for _, a := range q.Answers {
+			if len(a.Opens) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Trail records a player's way through a question graph: the questions that
+// are done (solved, or skipped on timeout) and the branches opened by the
+// answers given.
+type Trail struct {
+	Done   []int `json:"done,omitempty"`
+	Opened []int `json:"opened,omitempty"`
+}
+
+// Finish marks q as done and opens the branches of the answer given. a is
+// the zero Answer for skipped questions.
+func (t *Trail) Finish(q Question, a Answer) {
+	if !containsID(t.Done, q.ID) {
+		t.Done = append(t.Done, q.ID)
+	}
+	for _, id := range a.Opens {
+		if !containsID(t.Opened, id) {
+			t.Opened = append(t.Opened, id)
+		}
+	}
+}
+
+// IsDone reports whether the question with id is done.
+func (t Trail) IsDone(id int) bool {
+	return containsID(t.Done, id)
+}
+
+// Available returns the indexes of the questions the player can attempt
+// next, in pack order.
+func (c *Config) Available(t Trail) []int {
+	targets := c.branchTargets()
+	var out []int
+	for i, q := range c.Questions {
+		if t.IsDone(q.ID) || !c.unlocked(q, t, targets) {
+			continue
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// Finished reports whether no required question is left to attempt. Optional
+// side puzzles that are still available do not keep the game going.
+func (c *Config) Finished(t Trail) bool {
+	for _, i := range c.Available(t) {
+		if !c.Questions[i].Optional {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Config) unlocked(q Question, t Trail, targets map[int]bool) bool {
+	for _, id := range q.Requires {
+		if !t.IsDone(id) {
+			return false
+		}
+	}
+	if len(q.RequiresAny) > 0 {
+		met := false
+		for _, id := range q.RequiresAny {
+			met = met || t.IsDone(id)
+		}
+		if !met {
+			return false
+		}
+	}
+	return !targets[q.ID] || containsID(t.Opened, q.ID)
+}
+
+// branchTargets returns the ids opened by some answer; those questions stay
+// closed until that answer is given.
+func (c *Config) branchTargets() map[int]bool {
+	targets := make(map[int]bool)
+	for _, q := range c.Questions {
+		for _, a := range q.Answers {
+			for _, id := range a.Opens {
+				targets[id] = true
+			}
+		}
+	}
+	return targets
+}
+
+// Unreachable returns the ids of questions that can never become available,
+// even if the player gives every branching answer.
+func (c *Config) Unreachable() []int {
+	var all Trail
+	for {
+		progressed := false
+		for _, i := range c.Available(all) {
+			q := c.Questions[i]
+			all.Finish(q, Answer{})
+			for _, a := range q.Answers {
+				all.Finish(q, a)
+			}
+			progressed = true
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	var out []int
+	for _, q := range c.Questions {
+		if !all.IsDone(q.ID) {
+			out = append(out, q.ID)
+		}
+	}
+	return out
+}
+
+func validateGraph(c *Config) error {
+	ids := make(map[int]bool, len(c.Questions))
+	for _, q := range c.Questions {
+		ids[q.ID] = true
+	}
+	check := func(q Question, field string, refs []int) error {
+		for _, id := range refs {
+			if !ids[id] {
+				return fmt.Errorf("question %d: %s refers to unknown question %d", q.ID, field, id)
+			}
+		}
+		return nil
+	}
+	for _, q := range c.Questions {
+		if err := check(q, "requires", q.Requires); err != nil {
+			return err
+		}
+		if err := check(q, "requires_any", q.RequiresAny); err != nil {
+			return err
+		}
+		for _, a := range q.Answers {
+			if err := check(q, "opens", a.Opens); err != nil {
+				return err
+			}
+		}
+	}
+	if c.IsGraph() && len(c.Available(Trail{})) == 0 && len(c.Questions) > 0 {
+		return fmt.Errorf("question graph has no starting question")
+	}
+	return nil
+}
+
+func containsID(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package game
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func graphPack() []byte {
+	return []byte(`{
+		"questions": [
+			{"id": 1, "text": "Start", "answers": ["left", {"text": "right", "opens": [3]}]},
+			{"id": 2, "text": "Left", "requires": [1], "answers": ["a2"]},
+			{"id": 3, "text": "Right", "answers": ["a3"]},
+			{"id": 4, "text": "Gate", "requires_any": [2, 3], "answers": ["a4"]},
+			{"id": 5, "text": "Side", "optional": true, "requires": [1], "answers": ["a5"]}
+		],
+		"final_message": "EGG"
+	}`)
+}
+
+func availableIDs(c *Config, t Trail) []int {
+	var ids []int
+	for _, i := range c.Available(t) {
+		ids = append(ids, c.Questions[i].ID)
+	}
+	return ids
+}
+
+func TestGraphAvailability(t *testing.T) {
+	cfg, err := ParseConfig(graphPack())
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	if !cfg.IsGraph() {
+		t.Fatalf("expected a graph pack")
+	}
+
+	var trail Trail
+	if got := availableIDs(cfg, trail); !reflect.DeepEqual(got, []int{1}) {
+		t.Fatalf("start: available = %v", got)
+	}
+
+	q1 := cfg.Questions[0]
+	match, ok := MatchAnswer("right", q1)
+	if !ok {
+		t.Fatalf("expected the branching answer to match")
+	}
+	trail.Finish(q1, match)
+	if got := availableIDs(cfg, trail); !reflect.DeepEqual(got, []int{2, 3, 5}) {
+		t.Fatalf("after branch: available = %v", got)
+	}
+
+	trail.Finish(cfg.Questions[2], Answer{})
+	if got := availableIDs(cfg, trail); !reflect.DeepEqual(got, []int{2, 4, 5}) {
+		t.Fatalf("requires_any: available = %v", got)
+	}
+
+	trail.Finish(cfg.Questions[1], Answer{})
+	trail.Finish(cfg.Questions[3], Answer{})
+	if !cfg.Finished(trail) {
+		t.Fatalf("only the optional question is left, the game should be finished")
+	}
+}
+
+func TestGraphBranchStaysClosedWithoutOpeningAnswer(t *testing.T) {
+	cfg, err := ParseConfig(graphPack())
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	var trail Trail
+	trail.Finish(cfg.Questions[0], Answer{Text: "left"})
+	if got := availableIDs(cfg, trail); !reflect.DeepEqual(got, []int{2, 5}) {
+		t.Fatalf("available = %v", got)
+	}
+}
+
+func TestGraphValidation(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		pack string
+		want string
+	}{
+		{"unknown requires", `{"questions": [{"id": 1, "text": "Q", "answers": ["a"], "requires": [9]}]}`, "requires refers to unknown question 9"},
+		{"unknown opens", `{"questions": [{"id": 1, "text": "Q", "answers": [{"text": "a", "opens": [7]}]}]}`, "opens refers to unknown question 7"},
+		{"no start", `{"questions": [{"id": 1, "text": "Q", "answers": ["a"], "requires": [2]}, {"id": 2, "text": "Q", "answers": ["b"], "requires": [1]}]}`, "no starting question"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.pack))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLintReportsUnreachableQuestions(t *testing.T) {
+	var cfg Config
+	if err := json.Unmarshal([]byte(`{
+		"questions": [
+			{"id": 1, "text": "Q1", "hint": "h", "answers": ["a1"]},
+			{"id": 2, "text": "Q2", "hint": "h", "answers": ["a2"], "requires": [3]},
+			{"id": 3, "text": "Q3", "hint": "h", "answers": ["a3"], "requires": [2]}
+		],
+		"final_message": "EGG"
+	}`), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	var unreachable []int
+	for _, issue := range Lint(&cfg) {
+		if strings.Contains(issue.Message, "never be reached") {
+			unreachable = append(unreachable, issue.QuestionID)
+		}
+	}
+	if !reflect.DeepEqual(unreachable, []int{2, 3}) {
+		t.Fatalf("unreachable = %v", unreachable)
+	}
+}
+
+func TestPackDoesNotChainGraph(t *testing.T) {
+	packed, warnings, err := Pack(graphPack(), PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if len(warnings) == 0 || !strings.Contains(strings.Join(warnings, "\n"), "chaining is not supported") {
+		t.Fatalf("expected a chaining warning, got %v", warnings)
+	}
+	cfg, err := ParseConfig(packed)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	for _, q := range cfg.Questions {
+		if q.Locked() {
+			t.Fatalf("question %d should be readable", q.ID)
+		}
+	}
+	if match, ok := MatchAnswer("right", cfg.Questions[0]); !ok || !reflect.DeepEqual(match.Opens, []int{3}) {
+		t.Fatalf("hashed answer should keep its branch, got %+v %v", match, ok)
+	}
+}
@@ -0,0 +1,160 @@
+package game
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt cost parameters for answer hashes. N=2^14 keeps a single check around
+// tens of milliseconds while making offline dictionary attacks expensive.
+const (
+	hashN      = 1 << 14
+	hashR      = 8
+	hashP      = 1
+	hashKeyLen = 32
+	saltLen    = 16
+)
+
+// HashableChecker is an optional interface for checkers whose decision only
+// depends on a few normalized forms of the input. Answers for such checkers
+// can be stored as salted hashes of those forms instead of plaintext.
+//
+// Checkers that don't implement this (regex, numeric) need the plaintext
+// answer at runtime.
+type HashableChecker interface {
+	AnswerChecker
+	Forms(s string) []string
+}
+
+func (c exactChecker) Forms(s string) []string { return c.n.hashForms(s) }
+
+// Hashed fuzzy answers degrade to exact matching: typo tolerance would need
+// the plaintext.
+func (c fuzzyChecker) Forms(s string) []string { return c.n.hashForms(s) }
+
+func (caseSensitiveChecker) Forms(s string) []string {
+	return []string{strings.TrimSpace(s)}
+}
+
+func (c setChecker) Forms(s string) []string {
+	return []string{strings.Join(c.n.wordSet(s), " ")}
+}
+
+// HashAnswer returns the hex encoded scrypt hash of one normalized answer form.
+func HashAnswer(form string, salt []byte) (string, error) {
+	key, err := scrypt.Key([]byte(form), salt, hashN, hashR, hashP, hashKeyLen)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// HashAnswers replaces the plaintext of every hashable accepted answer, part
+// answer and response answer with salted hashes of its normalized forms. The legacy
+// Answer field is folded into Answers. It returns one warning per answer left
+// in plaintext because its checker cannot work on hashes.
+func (q *Question) HashAnswers() (warnings []string, err error) {
+	salt, err := q.salt()
+	if err != nil {
+		return nil, err
+	}
+
+	answers := q.AcceptedAnswers()
+	for i := range answers {
+		warning, err := q.hashAnswer(&answers[i], salt)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	for i := range q.Parts {
+		part := q.PartQuestion(i)
+		for j := range q.Parts[i].Answers {
+			warning, err := part.hashAnswer(&q.Parts[i].Answers[j], salt)
+			if err != nil {
+				return nil, err
+			}
+			if warning != "" {
+				warnings = append(warnings, fmt.Sprintf("%s (part %q)", warning, q.Parts[i].Name))
+			}
+		}
+	}
+	for i := range q.Responses {
+		warning, err := q.hashAnswer(&q.Responses[i].Answer, salt)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning+" (response)")
+		}
+	}
+
+	q.Answer = ""
+	q.Answers = answers
+	return warnings, nil
+}
+
+// hashAnswer hashes a in place, or returns a warning if its checker needs
+// the plaintext.
+func (q *Question) hashAnswer(a *Answer, salt []byte) (warning string, err error) {
+	if a.Text == "" {
+		return "", nil
+	}
+	checker, err := checkerFor(*q, *a)
+	if err != nil {
+		return "", fmt.Errorf("question %d: %w", q.ID, err)
+	}
+	hashable, ok := checker.(HashableChecker)
+	if !ok {
+		return fmt.Sprintf("question %d: %s answer stored in plaintext", q.ID, checker.Name()), nil
+	}
+
+	var hashes []string
+	for _, form := range hashable.Forms(a.Text) {
+		h, err := HashAnswer(form, salt)
+		if err != nil {
+			return "", fmt.Errorf("question %d: %w", q.ID, err)
+		}
+		hashes = append(hashes, h)
+	}
+	a.Text, a.Hashes = "", hashes
+	return "", nil
+}
+
+// hashedCheck compares the hashes of the input's forms with a hashed answer.
+// cache avoids re-hashing the same form for several aliases of one question.
+func hashedCheck(input string, q Question, a Answer, checker AnswerChecker, cache map[string]string) bool {
+	hashable, ok := checker.(HashableChecker)
+	if !ok {
+		return false
+	}
+	salt, err := hex.DecodeString(q.Salt)
+	if err != nil {
+		return false
+	}
+
+	for _, form := range hashable.Forms(input) {
+		if form == "" {
+			continue
+		}
+		h, ok := cache[form]
+		if !ok {
+			if h, err = HashAnswer(form, salt); err != nil {
+				return false
+			}
+			cache[form] = h
+		}
+		for _, want := range a.Hashes {
+			if subtle.ConstantTimeCompare([]byte(h), []byte(want)) == 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
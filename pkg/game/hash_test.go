@@ -0,0 +1,115 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestHashAnswersRemovesPlaintext(t *testing.T) {
+	q := Question{
+		ID:     5,
+		Answer: "ssh",
+		Answers: []Answer{
+			{Text: "Secure Shell"},
+			{Text: "42+-1", Match: CheckNumeric},
+		},
+	}
+
+	warnings, err := q.HashAnswers()
+	if err != nil {
+		t.Fatalf("HashAnswers: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected one plaintext warning for the numeric answer, got %v", warnings)
+	}
+
+	packed, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	lower := strings.ToLower(string(packed))
+	for _, secret := range []string{`"ssh"`, "secure"} {
+		if strings.Contains(lower, secret) {
+			t.Fatalf("packed question still contains %s: %s", secret, packed)
+		}
+	}
+
+	var loaded Question
+	if err := json.Unmarshal(packed, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"SSH", true},
+		{"secure-shell", true},
+		{"ssh!", true},
+		{"sssh", false}, // hashed answers lose typo tolerance
+		{"41.5", true},
+		{"telnet", false},
+	}
+	for _, tt := range tests {
+		if _, ok := MatchAnswer(tt.input, loaded); ok != tt.want {
+			t.Errorf("MatchAnswer(%q) = %v, want %v", tt.input, ok, tt.want)
+		}
+	}
+}
+
+func TestSealChainUnlocksWithPreviousAnswer(t *testing.T) {
+	cfg := Config{
+		Questions: []Question{
+			{ID: 1, Text: "first riddle", Answer: "echo", Hint: "sound"},
+			{ID: 2, Text: "second riddle", Answer: "keyboard", Answers: []Answer{{Text: "keys"}}, Hint: "typing"},
+		},
+		FinalMessage: "THE EGG IS IN THE FRIDGE",
+		FinalHint:    "cold",
+	}
+
+	if _, err := cfg.SealChain(); err != nil {
+		t.Fatalf("SealChain: %v", err)
+	}
+	for i := range cfg.Questions {
+		if _, err := cfg.Questions[i].HashAnswers(); err != nil {
+			t.Fatalf("HashAnswers: %v", err)
+		}
+	}
+
+	packed, err := json.Marshal(&cfg)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	for _, secret := range []string{"second riddle", "typing", "FRIDGE", "cold"} {
+		if strings.Contains(string(packed), secret) {
+			t.Fatalf("packed config leaks %q", secret)
+		}
+	}
+
+	var loaded Config
+	if err := json.Unmarshal(packed, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if loaded.Questions[0].Locked() || !loaded.Questions[1].Locked() || !loaded.FinaleLocked() {
+		t.Fatalf("expected only the first question to be readable")
+	}
+
+	if err := loaded.Unlock(0, "ecoh"); err != ErrLocked {
+		t.Fatalf("expected typo to stay locked, got %v", err)
+	}
+	if err := loaded.Unlock(0, " ECHO "); err != nil {
+		t.Fatalf("Unlock(0): %v", err)
+	}
+	if got := loaded.Questions[1]; got.Locked() || got.Text != "second riddle" || len(got.Hints) != 1 || got.Hints[0].Text != "typing" {
+		t.Fatalf("unexpected unlocked question: %+v", got)
+	}
+
+	// Any alias of the last question opens the finale.
+	if err := loaded.Unlock(1, "Keys"); err != nil {
+		t.Fatalf("Unlock(1): %v", err)
+	}
+	if loaded.FinaleLocked() || loaded.FinalMessage != "THE EGG IS IN THE FRIDGE" || loaded.FinalHint != "cold" {
+		t.Fatalf("unexpected finale: %q %q", loaded.FinalMessage, loaded.FinalHint)
+	}
+}
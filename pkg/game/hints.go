@@ -0,0 +1,96 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Hint is one step of a question's hint ladder. It is revealed once any of
+// its unlock rules is met. In packs it may be written as a plain string, which
+// unlocks after as many wrong answers as its position in the list (1, 2, ...):
+//
+//	"hints": [
+//	  "Think about sound.",
+//	  {"text": "It's a shell builtin.", "after_seconds": 90, "on_request": true}
+//	]
+type Hint struct {
+	Text string `json:"text"`
+
+	// AfterWrong reveals the hint after this many wrong answers.
+	AfterWrong int `json:"after_wrong,omitempty"`
+	// AfterSeconds reveals the hint after this long on the question.
+	AfterSeconds int `json:"after_seconds,omitempty"`
+	// OnRequest lets the player reveal the hint with the hint key.
+	OnRequest bool `json:"on_request,omitempty"`
+}
+
+func (h *Hint) UnmarshalJSON(b []byte) error {
+	var text string
+	if err := json.Unmarshal(b, &text); err == nil {
+		*h = Hint{Text: text}
+		return nil
+	}
+
+	type plain Hint
+	var obj plain
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return fmt.Errorf("hint must be a string or an object: %w", err)
+	}
+	*h = Hint(obj)
+	return nil
+}
+
+// hasRule reports whether the pack set any unlock rule for the hint.
+func (h Hint) hasRule() bool {
+	return h.AfterWrong > 0 || h.AfterSeconds > 0 || h.OnRequest
+}
+
+// Unlocked reports whether the wrong-answer or elapsed-time rule is met.
+// On-request hints are handled by RevealHints.
+func (h Hint) Unlocked(wrong int, elapsed time.Duration) bool {
+	if h.AfterWrong > 0 && wrong >= h.AfterWrong {
+		return true
+	}
+	return h.AfterSeconds > 0 && elapsed >= time.Duration(h.AfterSeconds)*time.Second
+}
+
+// HintLadder returns the question's hints in reveal order: the legacy Hint
+// (if set) followed by Hints. Hints without a rule unlock after as many wrong
+// answers as their position, so a single legacy hint keeps appearing after
+// the first wrong answer.
+func (q Question) HintLadder() []Hint {
+	ladder := make([]Hint, 0, len(q.Hints)+1)
+	if q.Hint != "" {
+		ladder = append(ladder, Hint{Text: q.Hint})
+	}
+	for _, h := range q.Hints {
+		if h.Text != "" {
+			ladder = append(ladder, h)
+		}
+	}
+	for i := range ladder {
+		if !ladder[i].hasRule() {
+			ladder[i].AfterWrong = i + 1
+		}
+	}
+	return ladder
+}
+
+// RevealHints returns how many hints of the ladder are revealed, given the
+// number already revealed. Hints are revealed strictly in order; requested
+// reveals the next hint if it allows on-request unlocking.
+func RevealHints(ladder []Hint, revealed, wrong int, elapsed time.Duration, requested bool) int {
+	for revealed < len(ladder) {
+		h := ladder[revealed]
+		switch {
+		case h.Unlocked(wrong, elapsed):
+		case requested && h.OnRequest:
+			requested = false
+		default:
+			return revealed
+		}
+		revealed++
+	}
+	return revealed
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestHintUnmarshalStringOrObject(t *testing.T) {
+	var q Question
+	raw := `{"id": 1, "hints": ["first", {"text": "second", "after_seconds": 30, "on_request": true}]}`
+	if err := json.Unmarshal([]byte(raw), &q); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	want := []Hint{
+		{Text: "first"},
+		{Text: "second", AfterSeconds: 30, OnRequest: true},
+	}
+	if len(q.Hints) != len(want) {
+		t.Fatalf("got %d hints, want %d", len(q.Hints), len(want))
+	}
+	for i := range want {
+		if q.Hints[i] != want[i] {
+			t.Errorf("hint %d = %+v, want %+v", i, q.Hints[i], want[i])
+		}
+	}
+}
+
+func TestHintLadderDefaults(t *testing.T) {
+	q := Question{
+		Hint:  "legacy",
+		Hints: []Hint{{Text: "plain"}, {Text: ""}, {Text: "timed", AfterSeconds: 60}},
+	}
+	ladder := q.HintLadder()
+	if len(ladder) != 3 {
+		t.Fatalf("expected empty hints to be dropped, got %+v", ladder)
+	}
+	if ladder[0].Text != "legacy" || ladder[0].AfterWrong != 1 {
+		t.Errorf("legacy hint should unlock after one wrong answer, got %+v", ladder[0])
+	}
+	if ladder[1].AfterWrong != 2 {
+		t.Errorf("plain hint should unlock after two wrong answers, got %+v", ladder[1])
+	}
+	if ladder[2].AfterWrong != 0 || ladder[2].AfterSeconds != 60 {
+		t.Errorf("explicit rules should be kept, got %+v", ladder[2])
+	}
+}
+
+func TestRevealHints(t *testing.T) {
+	ladder := []Hint{
+		{Text: "a", AfterWrong: 1},
+		{Text: "b", AfterSeconds: 60, OnRequest: true},
+		{Text: "c", AfterWrong: 2},
+	}
+	for _, tt := range []struct {
+		name      string
+		revealed  int
+		wrong     int
+		elapsed   time.Duration
+		requested bool
+		want      int
+	}{
+		{"nothing yet", 0, 0, 0, false, 0},
+		{"first wrong answer", 0, 1, 0, false, 1},
+		{"in order only", 0, 2, 0, false, 1},
+		{"request reveals next", 1, 1, 0, true, 2},
+		{"request reveals one", 1, 2, 0, true, 3},
+		{"request ignored when not allowed", 0, 0, 0, true, 0},
+		{"elapsed time", 1, 1, 61 * time.Second, false, 2},
+		{"elapsed time then wrong answers", 0, 2, 61 * time.Second, false, 3},
+		{"never hides", 3, 0, 0, false, 3},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RevealHints(ladder, tt.revealed, tt.wrong, tt.elapsed, tt.requested); got != tt.want {
+				t.Errorf("RevealHints = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package game
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrUnknownLevel is returned by Config.JumpToLevel for codes that open no
+// question.
+var ErrUnknownLevel = errors.New("unknown level code")
+
+// levelAlphabet leaves out characters that are easy to misread (I, L, O, U,
+// 0, 1) and N, which the intro screen uses to discard a saved game.
+const levelAlphabet = "ABCDEFGHJKMPQRSTVWXYZ23456789"
+
+const levelCodeLen = 8
+
+// levelSaltSuffix separates level keys from answer and unlock keys derived
+// from the same question salt.
+const levelSaltSuffix = "/level"
+
+// LevelCode returns the code for question index of a pack with the given
+// secret, formatted as two groups of four characters ("K7QX-2M9P").
+func LevelCode(secret []byte, index int) string {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "level/%d", index)
+	sum := mac.Sum(nil)
+
+	var b strings.Builder
+	for i := 0; i < levelCodeLen; i++ {
+		if i == levelCodeLen/2 {
+			b.WriteByte('-')
+		}
+		b.WriteByte(levelAlphabet[int(sum[i])%len(levelAlphabet)])
+	}
+	return b.String()
+}
+
+// NormalizeLevelCode upper-cases a typed code and drops separators.
+func NormalizeLevelCode(code string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(code) {
+		if strings.ContainsRune(levelAlphabet, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// levelSecret returns the secret level codes are derived from: the pack's
+// level_secret, or its fingerprint so codes stay stable until it is edited.
+func (c *Config) levelSecret() []byte {
+	if c.LevelSecret != "" {
+		return []byte(c.LevelSecret)
+	}
+	sum := sha256.Sum256([]byte("level/" + c.Fingerprint()))
+	return sum[:]
+}
+
+// validateLevels rejects level codes in packs where a code couldn't just
+// open a question: one of a question graph would skip its prerequisites,
+// and a category board has no order to continue in.
+func validateLevels(c *Config) error {
+	if !c.LevelCodes {
+		return nil
+	}
+	switch {
+	case c.IsGraph():
+		return errors.New("level_codes cannot be used in a question graph")
+	case c.IsBoard():
+		return errors.New("level_codes cannot be used with a category board")
+	}
+	return nil
+}
+
+// AssignLevels gives every readable question after the first its level code,
+// when the pack has level_codes enabled. Questions that already have a code,
+// or whose code is sealed, are left alone, so it is a no-op on packed packs.
+func (c *Config) AssignLevels() {
+	if !c.LevelCodes {
+		return
+	}
+	var secret []byte
+	for i := 1; i < len(c.Questions); i++ {
+		q := &c.Questions[i]
+		if q.Level != "" || q.LevelKey != "" || q.Locked() {
+			continue
+		}
+		if secret == nil {
+			secret = c.levelSecret()
+		}
+		q.Level = LevelCode(secret, i)
+	}
+}
+
+// JumpToLevel returns the index of the question a level code opens. For
+// chained packs the code also decrypts that question.
+func (c *Config) JumpToLevel(code string) (int, error) {
+	code = NormalizeLevelCode(code)
+	if len(code) != levelCodeLen {
+		return 0, ErrUnknownLevel
+	}
+
+	for i := 1; i < len(c.Questions); i++ {
+		q := &c.Questions[i]
+		if q.Level != "" {
+			if subtle.ConstantTimeCompare([]byte(NormalizeLevelCode(q.Level)), []byte(code)) == 1 {
+				return i, nil
+			}
+			continue
+		}
+		if q.LevelKey == "" {
+			continue
+		}
+
+		salt, err := hex.DecodeString(q.Salt)
+		if err != nil {
+			continue
+		}
+		key, err := levelKey(code, salt)
+		if err != nil {
+			return 0, err
+		}
+		contentKey, err := openBytes(key, q.LevelKey)
+		if err != nil {
+			continue
+		}
+		if err := c.openQuestion(i, contentKey); err != nil {
+			return 0, err
+		}
+		return i, nil
+	}
+	return 0, ErrUnknownLevel
+}
+
+func levelKey(code string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(NormalizeLevelCode(code)), append(append([]byte{}, salt...), levelSaltSuffix...), hashN, hashR, hashP, 32)
+}
@@ -0,0 +1,118 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestLevelCode(t *testing.T) {
+	code := LevelCode([]byte("secret"), 3)
+	if len(code) != levelCodeLen+1 || code[4] != '-' {
+		t.Fatalf("unexpected code format %q", code)
+	}
+	if code != LevelCode([]byte("secret"), 3) {
+		t.Fatalf("codes must be deterministic")
+	}
+	if code == LevelCode([]byte("secret"), 4) || code == LevelCode([]byte("other"), 3) {
+		t.Fatalf("codes must depend on index and secret")
+	}
+	if got := NormalizeLevelCode(" " + strings.ToLower(code) + " "); got != strings.ReplaceAll(code, "-", "") {
+		t.Fatalf("NormalizeLevelCode = %q", got)
+	}
+}
+
+func levelPack() []byte {
+	return []byte(`{
+		"level_codes": true,
+		"level_secret": "s3cret",
+		"questions": [
+			{"id": 1, "text": "Q1", "answers": ["a1"]},
+			{"id": 2, "text": "Q2", "answers": ["a2"]},
+			{"id": 3, "text": "Q3", "answers": ["a3"]}
+		],
+		"final_message": "EGG"
+	}`)
+}
+
+func TestAssignLevelsOnPlainPack(t *testing.T) {
+	cfg, err := ParseConfig(levelPack())
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	if cfg.Questions[0].Level != "" {
+		t.Errorf("the first question needs no code, got %q", cfg.Questions[0].Level)
+	}
+	want := LevelCode([]byte("s3cret"), 2)
+	if cfg.Questions[2].Level != want {
+		t.Fatalf("Level = %q, want %q", cfg.Questions[2].Level, want)
+	}
+	if i, err := cfg.JumpToLevel(strings.ToLower(want)); err != nil || i != 2 {
+		t.Fatalf("JumpToLevel = %d, %v", i, err)
+	}
+	if _, err := cfg.JumpToLevel("AAAA-AAAA"); err != ErrUnknownLevel {
+		t.Fatalf("expected unknown code to be rejected, got %v", err)
+	}
+}
+
+func TestLevelCodeOpensChainedQuestion(t *testing.T) {
+	packed, _, err := Pack(levelPack(), PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	code := LevelCode([]byte("s3cret"), 2)
+	for _, secret := range []string{"s3cret", code, strings.ReplaceAll(code, "-", "")} {
+		if strings.Contains(string(packed), secret) {
+			t.Fatalf("packed pack leaks %q", secret)
+		}
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(packed, &cfg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !cfg.Questions[2].Locked() {
+		t.Fatalf("expected question 3 to be sealed")
+	}
+	i, err := cfg.JumpToLevel(code)
+	if err != nil || i != 2 {
+		t.Fatalf("JumpToLevel = %d, %v", i, err)
+	}
+	if q := cfg.Questions[2]; q.Locked() || q.Text != "Q3" || q.Level != code {
+		t.Fatalf("expected the code to decrypt question 3, got %+v", q)
+	}
+}
+
+func TestLevelCodesOnlyInLinearPacks(t *testing.T) {
+	for name, pack := range map[string]string{
+		"graph": `{"level_codes": true, "questions": [
+			{"id": 1, "text": "Q1", "answers": ["a1"]},
+			{"id": 2, "text": "Q2", "answers": ["a2"], "requires": [1]}
+		], "final_message": "EGG"}`,
+		"board": `{"level_codes": true, "questions": [
+			{"id": 1, "text": "Q1", "answers": ["a1"], "category": "Crypto"},
+			{"id": 2, "text": "Q2", "answers": ["a2"], "category": "Web"}
+		], "final_message": "EGG"}`,
+	} {
+		if _, err := ParseConfig([]byte(pack)); err == nil || !strings.Contains(err.Error(), "level_codes") {
+			t.Errorf("%s: expected level_codes to be rejected, got %v", name, err)
+		}
+	}
+}
+
+func TestPackWarnsAboutReadableLevelCodes(t *testing.T) {
+	_, warnings, err := Pack(levelPack(), PackOptions{})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if !strings.Contains(strings.Join(warnings, "\n"), "level codes are stored readable") {
+		t.Fatalf("expected a warning about readable codes, got %q", warnings)
+	}
+	_, warnings, err = Pack(levelPack(), PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if strings.Contains(strings.Join(warnings, "\n"), "level codes") {
+		t.Fatalf("chained codes are sealed, got %q", warnings)
+	}
+}
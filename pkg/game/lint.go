@@ -0,0 +1,263 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Lint severities.
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// LintIssue is one problem found in a question pack.
+type LintIssue struct {
+	QuestionID int
+	Severity   string
+	Message    string
+}
+
+func (i LintIssue) String() string {
+	if i.QuestionID == 0 {
+		return fmt.Sprintf("%s: %s", i.Severity, i.Message)
+	}
+	return fmt.Sprintf("%s: question %d: %s", i.Severity, i.QuestionID, i.Message)
+}
+
+// commonGuesses are everyday words players type when guessing. A fuzzy answer
+// that accepts one of them (without being it) is likely to let wrong guesses
+// through.
+var commonGuesses = []string{
+	"answer", "apple", "back", "bash", "bird", "black", "blue", "book", "boot",
+	"bread", "cache", "call", "card", "care", "case", "cash", "chat", "chip",
+	"clock", "cloud", "code", "cold", "cook", "copy", "core", "data", "date",
+	"dead", "door", "down", "echo", "edge", "email", "exit", "face", "fail",
+	"file", "fire", "fish", "flag", "food", "fork", "game", "gate", "ghost",
+	"gold", "good", "green", "hack", "hand", "hash", "head", "heart", "help",
+	"hero", "home", "host", "house", "idea", "item", "java", "join", "jump",
+	"keys", "kill", "king", "lamp", "land", "last", "leaf", "left", "life",
+	"light", "line", "link", "list", "load", "lock", "login", "long", "loop",
+	"love", "mail", "main", "make", "mask", "master", "meta", "mind", "mode",
+	"moon", "mouse", "move", "name", "need", "nest", "news", "node", "none",
+	"note", "null", "open", "page", "pass", "password", "path", "ping", "pipe",
+	"plan", "play", "port", "post", "power", "print", "queen", "query", "quit",
+	"rain", "read", "root", "rose", "route", "ruby", "rust", "safe", "save",
+	"scan", "seed", "send", "server", "shell", "ship", "shop", "sign", "sleep",
+	"snake", "socket", "song", "sound", "star", "start", "stop", "sudo", "tail",
+	"tape", "team", "test", "text", "time", "token", "tree", "true", "user",
+	"view", "void", "wall", "water", "wave", "wind", "window", "wire", "word",
+	"work", "world", "yes", "zero",
+}
+
+// LintPack reports problems in a plain question pack. Only malformed JSON is
+// returned as an error; everything else is an issue so all problems are
+// reported in one run.
+func LintPack(raw []byte) ([]LintIssue, error) {
+	var config Config
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse game data: %w", err)
+	}
+	config.inheritNormalize()
+	return Lint(&config), nil
+}
+
+// Lint reports duplicate ids, unanswerable questions, missing hints, invalid
+// checker settings, and fuzzy answers that are too easy to hit by accident.
+func Lint(c *Config) []LintIssue {
+	var issues []LintIssue
+	add := func(id int, severity, format string, args ...any) {
+		issues = append(issues, LintIssue{QuestionID: id, Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(c.Questions) == 0 {
+		add(0, LintError, "pack has no questions")
+	}
+	if c.FinalMessage == "" && !c.FinaleLocked() {
+		add(0, LintWarning, "final_message is empty")
+	}
+
+	if r := c.Scoring; r != nil && (r.Points < 0 || r.TimeBonus < 0 || r.TimeBonusSeconds < 0 || r.HintPenalty < 0 || r.WrongPenalty < 0) {
+		add(0, LintError, "scoring values must not be negative")
+	}
+
+	if c.LevelCodes && c.LevelSecret == "" && !c.FinaleLocked() {
+		add(0, LintWarning, "level_codes without level_secret: codes change whenever the pack is edited")
+	}
+	if err := validateTimers(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+
+	seen := make(map[int]bool)
+	for _, q := range c.Questions {
+		if seen[q.ID] {
+			add(q.ID, LintError, "duplicate id")
+		}
+		seen[q.ID] = true
+
+		if strings.TrimSpace(q.Text) == "" && !q.Locked() {
+			add(q.ID, LintError, "text is empty")
+		}
+		if len(q.HintLadder()) == 0 && !q.Locked() {
+			add(q.ID, LintWarning, "hint is missing")
+		}
+
+		if q.Points < 0 {
+			add(q.ID, LintError, "points is negative")
+		}
+
+		if len(q.Parts) > 0 {
+			lintParts(q, add)
+			continue
+		}
+
+		answers := q.AcceptedAnswers()
+		if len(answers) == 0 {
+			add(q.ID, LintError, "no accepted answers")
+		}
+		for _, a := range q.Answers {
+			if strings.TrimSpace(a.Text) == "" && !a.Hashed() {
+				add(q.ID, LintError, "empty entry in answers")
+			}
+		}
+
+		for _, a := range answers {
+			checker, err := checkerFor(q, a)
+			if err != nil {
+				add(q.ID, LintError, "%v", err)
+				continue
+			}
+			if a.Hashed() {
+				continue
+			}
+			lintAnswerSyntax(q, a, checker, add)
+			if checker.Name() == CheckFuzzy {
+				for _, guess := range commonGuesses {
+					if NormalizeString(guess) != NormalizeString(a.Text) && checker.Check(guess, a.Text) {
+						add(q.ID, LintWarning, "fuzzy answer %q also accepts the common guess %q", a.Text, guess)
+					}
+				}
+			}
+		}
+		lintResponses(q, add)
+		if len(q.Choices) > 0 {
+			if err := validateChoices(q); err != nil {
+				add(q.ID, LintError, "%v", err)
+			}
+		}
+	}
+
+	if err := validateGraph(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+	if err := validateBoard(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+	if err := validatePools(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+	if err := validateFlag(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+	if err := validateLockout(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+	if err := validateLevels(c); err != nil {
+		add(0, LintError, "%v", err)
+	}
+	if c.LevelCodes && c.Randomized() {
+		add(0, LintWarning, "level codes with pools or shuffle only work for players who drew that question")
+	}
+	if c.IsGraph() {
+		for _, id := range c.Unreachable() {
+			add(id, LintWarning, "can never be reached in the question graph")
+		}
+	}
+
+	issues = append(issues, lintCrossAnswers(c)...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].QuestionID < issues[j].QuestionID
+	})
+	return issues
+}
+
+func lintAnswerSyntax(q Question, a Answer, checker AnswerChecker, add func(int, string, string, ...any)) {
+	if err := answerSyntax(a, checker); err != nil {
+		add(q.ID, LintError, "%v", err)
+	}
+	if strings.TrimSpace(a.Text) == "" {
+		return
+	}
+	if normalizeEnhanced(a.Text) == "" && checker.Name() != CheckRegex && checker.Name() != CheckCaseSensitive {
+		add(q.ID, LintError, "answer %q normalizes to nothing and can never match", a.Text)
+	}
+}
+
+// lintParts checks the parts of a multi-part question and the syntax of
+// their answers.
+func lintParts(q Question, add func(int, string, string, ...any)) {
+	if err := validateParts(q); err != nil {
+		add(q.ID, LintError, "%v", err)
+		return
+	}
+	for i := range q.Parts {
+		part := q.PartQuestion(i)
+		for _, a := range part.AcceptedAnswers() {
+			if a.Hashed() {
+				continue
+			}
+			checker, _ := checkerFor(part, a)
+			lintAnswerSyntax(part, a, checker, add)
+		}
+	}
+}
+
+// lintResponses checks response settings and flags responses that an accepted
+// answer already matches, since those replies can never be shown.
+func lintResponses(q Question, add func(int, string, string, ...any)) {
+	if err := validateResponses(q); err != nil {
+		add(q.ID, LintError, "%v", err)
+		return
+	}
+	for _, r := range q.Responses {
+		if r.Answer.Hashed() {
+			continue
+		}
+		checker, _ := checkerFor(q, r.Answer)
+		lintAnswerSyntax(q, r.Answer, checker, add)
+		if _, ok := MatchAnswer(r.Answer.Text, q); ok {
+			add(q.ID, LintWarning, "response to %q is never shown: that answer is accepted", r.Answer.Text)
+		}
+	}
+}
+
+// lintCrossAnswers flags answers of different questions that the other
+// question's checker would accept, which usually means a copy-paste slip.
+func lintCrossAnswers(c *Config) []LintIssue {
+	var issues []LintIssue
+	for i, q := range c.Questions {
+		for j, other := range c.Questions {
+			// Choice numbers are picked, not typed, so they can't be
+			// mixed up between questions.
+			if i == j || len(q.Choices) > 0 || len(other.Choices) > 0 {
+				continue
+			}
+			for _, theirs := range other.AcceptedAnswers() {
+				if theirs.Hashed() {
+					continue
+				}
+				if _, ok := MatchAnswer(theirs.Text, q); ok {
+					issues = append(issues, LintIssue{
+						QuestionID: q.ID,
+						Severity:   LintWarning,
+						Message:    fmt.Sprintf("also accepts %q, an answer of question %d", theirs.Text, other.ID),
+					})
+				}
+			}
+		}
+	}
+	return issues
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintReportsPackProblems(t *testing.T) {
+	raw := `{
+		"questions": [
+			{"id": 1, "text": "Q1", "answer": "shell", "hint": "h"},
+			{"id": 1, "text": "Q2", "answer": "shel", "hint": ""},
+			{"id": 3, "text": "Q3", "answers": [""], "hint": "h"},
+			{"id": 4, "text": "Q4", "answer": "(", "check": "regex", "hint": "h"},
+			{"id": 5, "text": "Q5", "answer": "x", "check": "telepathy", "hint": "h"}
+		],
+		"final_message": "EGG"
+	}`
+
+	issues, err := LintPack([]byte(raw))
+	if err != nil {
+		t.Fatalf("LintPack: %v", err)
+	}
+
+	var report []string
+	for _, issue := range issues {
+		report = append(report, issue.String())
+	}
+	joined := strings.Join(report, "\n")
+
+	for _, want := range []string{
+		"error: question 1: duplicate id",
+		"warning: question 1: hint is missing",
+		`also accepts "shell", an answer of question 1`,
+		"error: question 3: no accepted answers",
+		"error: question 3: empty entry in answers",
+		`error: question 4: invalid regex "("`,
+		`error: question 5: unknown answer checker "telepathy"`,
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("missing issue %q in report:\n%s", want, joined)
+		}
+	}
+}
+
+func TestLintFlagsFuzzyCommonGuesses(t *testing.T) {
+	cfg := &Config{
+		Questions:    []Question{{ID: 1, Text: "Q", Answer: "hosts", Hint: "h"}},
+		FinalMessage: "EGG",
+	}
+	issues := Lint(cfg)
+	if len(issues) == 0 || !strings.Contains(issues[0].Message, `common guess "host"`) {
+		t.Fatalf("expected fuzzy collision with a common guess, got %v", issues)
+	}
+}
+
+func TestLintCleanPack(t *testing.T) {
+	cfg := &Config{
+		Questions:    []Question{{ID: 1, Text: "Q", Answer: "footsteps", Hint: "h"}},
+		FinalMessage: "EGG",
+	}
+	if issues := Lint(cfg); len(issues) != 0 {
+		t.Fatalf("expected no issues, got %v", issues)
+	}
+}
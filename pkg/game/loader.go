@@ -1,19 +1,66 @@
 package game
 
 import (
+	"bytes"
 	"ctf-tool/pkg/data"
 	"encoding/json"
 	"fmt"
+	"os"
 )
 
-func LoadConfig() (*Config, error) {
-	raw := data.LoadRawData()
+// LoadConfig loads the question pack at path, or the pack shipped with the
+// binary (see data.LoadRawData) when path is empty. Files may be JSON, YAML,
+// or TOML, or the obfuscated output of cmd/packer.
+func LoadConfig(path string) (*Config, error) {
+	var raw []byte
+	var err error
+	if path == "" {
+		raw, err = data.LoadRawData()
+	} else {
+		raw, err = ReadPack(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read question pack: %w", err)
+	}
+	return ParseConfig(raw)
+}
+
+// ReadPack reads a JSON, YAML, or TOML pack file (by extension), undoes the
+// packer's obfuscation if needed, and returns it as current-schema JSON.
+func ReadPack(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	format := FormatFromPath(path)
+	if format == FormatJSON && !isPlainJSON(raw) {
+		raw = data.Deobfuscate(raw)
+	}
+	return NormalizePack(raw, format)
+}
+
+// ParseConfig decodes, migrates, and validates a plain JSON question pack.
+func ParseConfig(raw []byte) (*Config, error) {
+	raw, err := NormalizePack(raw, FormatJSON)
+	if err != nil {
+		return nil, err
+	}
 
 	var config Config
-	err := json.Unmarshal(raw, &config)
+	err = json.Unmarshal(raw, &config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse game data: %w", err)
 	}
+	config.inheritNormalize()
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid game data: %w", err)
+	}
+	config.AssignLevels()
 
 	return &config, nil
 }
+
+func isPlainJSON(raw []byte) bool {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n\ufeff")
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
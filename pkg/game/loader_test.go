@@ -0,0 +1,48 @@
+package game
+
+import (
+	"ctf-tool/pkg/data"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testPack = `{"questions": [{"id": 1, "text": "Q1", "answer": "A1"}], "final_message": "EGG"}`
+
+func TestLoadConfigFromFile(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "plain.json")
+	packed := filepath.Join(dir, "hunt.pack")
+	if err := os.WriteFile(plain, []byte("\n"+testPack), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(packed, data.Obfuscate([]byte(testPack)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{plain, packed} {
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			t.Fatalf("LoadConfig(%s): %v", filepath.Base(path), err)
+		}
+		if len(cfg.Questions) != 1 || cfg.FinalMessage != "EGG" {
+			t.Fatalf("LoadConfig(%s) = %+v", filepath.Base(path), cfg)
+		}
+	}
+}
+
+func TestLoadConfigEmbedded(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig(\"\"): %v", err)
+	}
+	if len(cfg.Questions) == 0 {
+		t.Fatalf("expected embedded pack to contain questions")
+	}
+}
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatalf("expected an error for a missing pack")
+	}
+}
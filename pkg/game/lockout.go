@@ -0,0 +1,137 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// LockoutRules slow down guessing. After every After wrong answers in a row
+// the player is locked out for Cooldown seconds; each further lockout lasts
+// Factor times as long as the one before, up to MaxCooldown.
+type LockoutRules struct {
+	After       int     `json:"after"`
+	Cooldown    int     `json:"cooldown,omitempty"`
+	Factor      float64 `json:"factor,omitempty"`
+	MaxCooldown int     `json:"max_cooldown,omitempty"`
+}
+
+// DefaultLockoutRules fill in what a pack's lockout block leaves out.
+var DefaultLockoutRules = LockoutRules{
+	After:       5,
+	Cooldown:    30,
+	Factor:      2,
+	MaxCooldown: 3600,
+}
+
+// LockoutRules returns the pack's lockout rules with defaults filled in. ok
+// is false when the pack has no lockout block, and guessing is unlimited.
+func (c *Config) LockoutRules() (rules LockoutRules, ok bool) {
+	if c.Lockout == nil {
+		return LockoutRules{}, false
+	}
+	rules = *c.Lockout
+	if rules.After == 0 {
+		rules.After = DefaultLockoutRules.After
+	}
+	if rules.Cooldown == 0 {
+		rules.Cooldown = DefaultLockoutRules.Cooldown
+	}
+	if rules.Factor == 0 {
+		rules.Factor = DefaultLockoutRules.Factor
+	}
+	if rules.MaxCooldown == 0 {
+		rules.MaxCooldown = max(DefaultLockoutRules.MaxCooldown, rules.Cooldown)
+	}
+	return rules, true
+}
+
+// CooldownFor returns how long the n-th lockout (from 1) lasts.
+func (r LockoutRules) CooldownFor(n int) time.Duration {
+	seconds := float64(r.Cooldown) * math.Pow(r.Factor, float64(n-1))
+	if seconds > float64(r.MaxCooldown) {
+		seconds = float64(r.MaxCooldown)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// Lockout is a player's guessing state under LockoutRules. Until is wall
+// clock time, so it survives a restart.
+type Lockout struct {
+	// Strikes are the wrong answers since the last lockout or solve, and
+	// Count the lockouts since the last solve.
+	Strikes int       `json:"strikes,omitempty"`
+	Count   int       `json:"count,omitempty"`
+	Until   time.Time `json:"until,omitempty"`
+}
+
+// Remaining returns how long the lockout still lasts at now, or 0.
+func (l Lockout) Remaining(now time.Time) time.Duration {
+	if l.Until.IsZero() || !now.Before(l.Until) {
+		return 0
+	}
+	return l.Until.Sub(now)
+}
+
+// Fail records a wrong answer and returns the cooldown it started, or 0.
+func (l *Lockout) Fail(r LockoutRules, now time.Time) time.Duration {
+	l.Strikes++
+	if r.After <= 0 || l.Strikes < r.After {
+		return 0
+	}
+	l.Strikes = 0
+	l.Count++
+	cooldown := r.CooldownFor(l.Count)
+	l.Until = now.Add(cooldown)
+	return cooldown
+}
+
+// Merge keeps the longer lockout of l and other, and the higher counts.
+func (l *Lockout) Merge(other Lockout) {
+	if other.Until.After(l.Until) {
+		l.Until = other.Until
+	}
+	l.Strikes = max(l.Strikes, other.Strikes)
+	l.Count = max(l.Count, other.Count)
+}
+
+// FormatLockout renders l as "strikes,count,until" with until in Unix
+// seconds (rounded up, 0 for none), for passing it between processes.
+func FormatLockout(l Lockout) string {
+	var until int64
+	if !l.Until.IsZero() {
+		until = l.Until.Add(time.Second - 1).Unix()
+	}
+	return fmt.Sprintf("%d,%d,%d", l.Strikes, l.Count, until)
+}
+
+// ParseLockout parses a lockout written by FormatLockout.
+func ParseLockout(s string) (Lockout, error) {
+	var l Lockout
+	var until int64
+	if _, err := fmt.Sscanf(s, "%d,%d,%d", &l.Strikes, &l.Count, &until); err != nil {
+		return Lockout{}, fmt.Errorf("invalid lockout %q: %w", s, err)
+	}
+	if l.Strikes < 0 || l.Count < 0 || until < 0 {
+		return Lockout{}, fmt.Errorf("invalid lockout %q", s)
+	}
+	if until > 0 {
+		l.Until = time.Unix(until, 0)
+	}
+	return l, nil
+}
+
+func validateLockout(c *Config) error {
+	r := c.Lockout
+	if r == nil {
+		return nil
+	}
+	if r.After < 0 || r.Cooldown < 0 || r.MaxCooldown < 0 {
+		return errors.New("lockout values must not be negative")
+	}
+	if r.Factor != 0 && r.Factor < 1 {
+		return errors.New("lockout factor must be at least 1")
+	}
+	return nil
+}
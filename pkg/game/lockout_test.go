@@ -0,0 +1,71 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutBacksOffExponentially(t *testing.T) {
+	cfg := &Config{Lockout: &LockoutRules{After: 3, Cooldown: 10, MaxCooldown: 30}}
+	rules, ok := cfg.LockoutRules()
+	if !ok || rules.Factor != 2 {
+		t.Fatalf("LockoutRules = %+v, %v", rules, ok)
+	}
+
+	now := time.Date(2026, 10, 31, 18, 0, 0, 0, time.UTC)
+	var l Lockout
+	var cooldowns []time.Duration
+	for i := 0; i < 12; i++ {
+		if d := l.Fail(rules, now); d > 0 {
+			cooldowns = append(cooldowns, d)
+			if l.Remaining(now) != d || l.Remaining(now.Add(d)) != 0 {
+				t.Fatalf("lockout %d: remaining %s", l.Count, l.Remaining(now))
+			}
+		}
+	}
+	want := []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second}
+	if len(cooldowns) != len(want) {
+		t.Fatalf("cooldowns = %v, want %v", cooldowns, want)
+	}
+	for i := range want {
+		if cooldowns[i] != want[i] {
+			t.Fatalf("cooldowns = %v, want %v", cooldowns, want)
+		}
+	}
+
+	if _, ok := (&Config{}).LockoutRules(); ok {
+		t.Fatalf("packs without a lockout block allow unlimited guesses")
+	}
+}
+
+func TestValidateLockout(t *testing.T) {
+	for _, rules := range []LockoutRules{{After: -1}, {Cooldown: -5}, {Factor: 0.5}} {
+		cfg := &Config{Lockout: &rules}
+		if err := validateLockout(cfg); err == nil {
+			t.Errorf("validateLockout(%+v) should fail", rules)
+		}
+	}
+	if err := validateLockout(&Config{Lockout: &LockoutRules{After: 3}}); err != nil {
+		t.Errorf("validateLockout: %v", err)
+	}
+}
+
+func TestFormatAndParseLockout(t *testing.T) {
+	l := Lockout{Strikes: 2, Count: 3, Until: time.Unix(1790000000, 500)}
+	got, err := ParseLockout(FormatLockout(l))
+	if err != nil {
+		t.Fatalf("ParseLockout: %v", err)
+	}
+	// Until is rounded up to the second, so the lockout never gets shorter.
+	if got.Strikes != 2 || got.Count != 3 || got.Until.Unix() != 1790000001 {
+		t.Fatalf("round trip = %+v", got)
+	}
+	if zero, err := ParseLockout(FormatLockout(Lockout{})); err != nil || zero != (Lockout{}) {
+		t.Fatalf("empty lockout = %+v, %v", zero, err)
+	}
+	for _, s := range []string{"", "1,2", "-1,0,0", "a,b,c"} {
+		if _, err := ParseLockout(s); err == nil {
+			t.Errorf("ParseLockout(%q) should fail", s)
+		}
+	}
+}
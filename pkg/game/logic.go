@@ -29,24 +29,77 @@ func normalizeEnhanced(s string) string {
 	return b.String()
 }
 
-func fuzzyMatch(input, correct string) bool {
+// Outcome is the verdict of CheckAnswer.
+type Outcome int
+
+const (
+	NoMatch Outcome = iota
+	// NearMiss is one typo beyond the tolerance for the answer's length.
+	NearMiss
+	Matched
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Matched:
+		return "match"
+	case NearMiss:
+		return "near_miss"
+	}
+	return "no_match"
+}
+
+// Normalizations CheckAnswer compares under (see CheckResult.Form).
+const (
+	FormLegacy   = "legacy"
+	FormEnhanced = "enhanced"
+)
+
+// CheckResult describes how close an input came to a fuzzy answer: the
+// outcome, the edit distance, and the normalization it was measured under.
+// Distance and Form are those of the closer normalization.
+type CheckResult struct {
+	Outcome  Outcome
+	Distance int
+	Form     string
+}
+
+// Matched reports whether the input was accepted.
+func (r CheckResult) Matched() bool { return r.Outcome == Matched }
+
+// fuzzyTolerance is the number of typos accepted for an answer of length
+// runes. Short answers stay strict to avoid accidental passes.
+func fuzzyTolerance(length int) int {
+	if length <= 3 {
+		return 0
+	} else if length <= 6 {
+		return 1
+	}
+	return 2
+}
+
+func fuzzyMatch(input, correct, form string) CheckResult {
 	if input == correct {
-		return true
+		return CheckResult{Outcome: Matched, Form: form}
 	}
 	if input == "" || correct == "" {
-		return false
+		return CheckResult{Outcome: NoMatch, Distance: max(len([]rune(input)), len([]rune(correct))), Form: form}
 	}
 
 	dist := LevenshteinDistance(input, correct)
 	length := len([]rune(correct))
+	tolerance := fuzzyTolerance(length)
 
-	// Keep short answers strict to avoid accidental passes.
-	if length <= 3 {
-		return dist == 0
-	} else if length <= 6 {
-		return dist <= 1
+	result := CheckResult{Outcome: NoMatch, Distance: dist, Form: form}
+	switch {
+	case dist <= tolerance:
+		result.Outcome = Matched
+	case dist == tolerance+1 && length > 3:
+		// One edit is a third of a three-letter answer; calling that close
+		// would give it away.
+		result.Outcome = NearMiss
 	}
-	return dist <= 2
+	return result
 }
 
 // LevenshteinDistance calculates the Optimal String Alignment distance (restricted Damerau-Levenshtein)
@@ -103,15 +156,108 @@ func min(vals ...int) int {
 	return m
 }
 
-// CheckAnswer validates the user input against the correct answer with fuzzy matching
-func CheckAnswer(input, correct string) bool {
-	legacyInput := NormalizeString(input)
-	legacyCorrect := NormalizeString(correct)
-	if fuzzyMatch(legacyInput, legacyCorrect) {
+// CheckAnswer validates the user input against the correct answer with fuzzy
+// matching, first under the legacy normalization and then the enhanced one.
+func CheckAnswer(input, correct string) CheckResult {
+	return Normalizer{}.CheckAnswer(input, correct)
+}
+
+// CheckAnswer is the fuzzy check under every form of n in turn. Exact forms
+// come first and decide on their own when both sides have one: two different
+// numbers are no match, however similar they look. Without a match it
+// returns the closest result.
+func (n Normalizer) CheckAnswer(input, correct string) CheckResult {
+	got, want := n.forms(input), n.forms(correct)
+	for i := range want {
+		if !want[i].exact || want[i].text == "" || got[i].text == "" {
+			continue
+		}
+		r := CheckResult{Outcome: Matched, Form: want[i].name}
+		if got[i].text != want[i].text {
+			r.Outcome, r.Distance = NoMatch, LevenshteinDistance(got[i].text, want[i].text)
+		}
+		return r
+	}
+
+	var best CheckResult
+	first := true
+	for i := range want {
+		if want[i].exact {
+			continue
+		}
+		r := fuzzyMatch(got[i].text, want[i].text, want[i].name)
+		if r.Matched() {
+			return r
+		}
+		if first || r.Outcome > best.Outcome || (r.Outcome == best.Outcome && r.Distance < best.Distance) {
+			best, first = r, false
+		}
+	}
+	return best
+}
+
+// exactMatch compares the normalized forms without any typo tolerance.
+func (n Normalizer) exactMatch(input, correct string) bool {
+	got, want := n.forms(input), n.forms(correct)
+	if got[0].text == want[0].text {
 		return true
 	}
+	for i := 1; i < len(want); i++ {
+		if want[i].text != "" && got[i].text == want[i].text {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAnswer checks the input against every accepted answer of q, using
+// each alias' checker (see AnswerChecker), and returns the alias that matched
+// first. Hashed answers only match the exact normalized forms.
+func MatchAnswer(input string, q Question) (Answer, bool) {
+	return NewGuess(input, q).Answer()
+}
+
+// Guess is one input checked against a question, first against its
+// accepted answers and then, if wrong, against its responses. Every form of
+// the input is hashed only once for all of them.
+type Guess struct {
+	Input string
+	m     answerMatcher
+}
 
-	enhancedInput := normalizeEnhanced(input)
-	enhancedCorrect := normalizeEnhanced(correct)
-	return fuzzyMatch(enhancedInput, enhancedCorrect)
+// NewGuess prepares input for checking against q.
+func NewGuess(input string, q Question) *Guess {
+	return &Guess{Input: input, m: answerMatcher{q: q}}
+}
+
+// Answer returns the accepted answer the input matches first (see
+// MatchAnswer).
+func (g *Guess) Answer() (Answer, bool) {
+	for _, a := range g.m.q.AcceptedAnswers() {
+		if g.m.match(g.Input, a) {
+			return a, true
+		}
+	}
+	return Answer{}, false
+}
+
+// answerMatcher checks one input against several answers of a question,
+// hashing each input form only once.
+type answerMatcher struct {
+	q         Question
+	hashCache map[string]string
+}
+
+func (m *answerMatcher) match(input string, a Answer) bool {
+	checker, err := checkerFor(m.q, a)
+	if err != nil {
+		return false
+	}
+	if a.Hashed() {
+		if m.hashCache == nil {
+			m.hashCache = make(map[string]string)
+		}
+		return hashedCheck(input, m.q, a, checker, m.hashCache)
+	}
+	return checker.Check(input, a.Text)
 }
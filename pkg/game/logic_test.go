@@ -1,6 +1,10 @@
 package game
 
-import "testing"
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
 
 func TestCheckAnswer(t *testing.T) {
 	tests := []struct {
@@ -32,8 +36,138 @@ func TestCheckAnswer(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		if got := CheckAnswer(tt.input, tt.correct); got != tt.expected {
+		if got := CheckAnswer(tt.input, tt.correct).Matched(); got != tt.expected {
 			t.Errorf("CheckAnswer(%q, %q) = %v, want %v", tt.input, tt.correct, got, tt.expected)
 		}
 	}
 }
+
+func TestCheckAnswerResult(t *testing.T) {
+	tests := []struct {
+		input, correct string
+		outcome        Outcome
+		distance       int
+		form           string
+	}{
+		{"ECHO", "echo", Matched, 0, FormLegacy},
+		{"FLAG{alpha-beta}", "flag alpha beta", Matched, 0, FormEnhanced},
+		{"ehco", "echo", Matched, 1, FormLegacy},
+		{"ehc", "echo", NearMiss, 2, FormLegacy},
+		{"keybrd", "keyboard", Matched, 2, FormLegacy},
+		{"kybrd", "keyboard", NearMiss, 3, FormLegacy},
+		{"k-y-b-r-d", "keyboard", NearMiss, 3, FormEnhanced},
+		{"bat", "cat", NoMatch, 1, FormLegacy},
+		{"mouse", "keyboard", NoMatch, 7, FormLegacy},
+	}
+
+	for _, tt := range tests {
+		got := CheckAnswer(tt.input, tt.correct)
+		if got.Outcome != tt.outcome || got.Distance != tt.distance || got.Form != tt.form {
+			t.Errorf("CheckAnswer(%q, %q) = %+v, want %s distance %d form %s", tt.input, tt.correct, got, tt.outcome, tt.distance, tt.form)
+		}
+	}
+}
+
+func TestFindNearMiss(t *testing.T) {
+	q := Question{
+		Answer: "keyboard",
+		Answers: []Answer{
+			{Text: "footsteps", Match: MatchExact},
+			{Text: "ssh"},
+		},
+	}
+	if r, ok := FindNearMiss("kybrd", q); !ok || r.Distance != 3 {
+		t.Fatalf("FindNearMiss = %+v, %v", r, ok)
+	}
+	for _, input := range []string{"keyboard", "fotstps", "sh", "mouse"} {
+		if r, ok := FindNearMiss(input, q); ok {
+			t.Errorf("FindNearMiss(%q) = %+v, want none", input, r)
+		}
+	}
+
+	q.Answers = nil
+	if _, err := q.HashAnswers(); err != nil {
+		t.Fatalf("HashAnswers: %v", err)
+	}
+	if _, ok := FindNearMiss("kybrd", q); ok {
+		t.Fatalf("hashed answers must not report near misses")
+	}
+}
+
+func TestMatchAnswerAliases(t *testing.T) {
+	q := Question{
+		Answer: "ssh",
+		Answers: []Answer{
+			{Text: "secure shell"},
+			{Text: "openssh", Match: MatchExact},
+		},
+	}
+
+	tests := []struct {
+		input string
+		alias string
+		ok    bool
+	}{
+		{"SSH", "ssh", true},
+		{"secure-shell", "secure shell", true},
+		{"secure shel", "secure shell", true},
+		{"OpenSSH", "openssh", true},
+		{"open ssh", "openssh", true},
+		{"opensh", "", false}, // exact alias: no typo budget
+		{"telnet", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := MatchAnswer(tt.input, q)
+		if ok != tt.ok || got.Text != tt.alias {
+			t.Errorf("MatchAnswer(%q) = (%q, %v), want (%q, %v)", tt.input, got.Text, ok, tt.alias, tt.ok)
+		}
+	}
+}
+
+func TestAnswerUnmarshalStringOrObject(t *testing.T) {
+	var q Question
+	raw := `{"id": 1, "answers": ["echo", {"text": "ECHO1", "match": "exact"}]}`
+	if err := json.Unmarshal([]byte(raw), &q); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	want := []Answer{{Text: "echo"}, {Text: "ECHO1", Match: MatchExact}}
+	if len(q.Answers) != len(want) {
+		t.Fatalf("got %d answers, want %d", len(q.Answers), len(want))
+	}
+	for i := range want {
+		if q.Answers[i].Text != want[i].Text || q.Answers[i].Match != want[i].Match {
+			t.Errorf("answer %d = %+v, want %+v", i, q.Answers[i], want[i])
+		}
+	}
+}
+
+func TestPackWarnsAboutLostNearMisses(t *testing.T) {
+	raw := `{"questions": [
+		{"id": 1, "text": "Q1", "answer": "footsteps", "hint": "h"},
+		{"id": 2, "text": "Q2", "answer": "ssh", "hint": "h"},
+		{"id": 3, "text": "Q3", "answer": "keyboard", "check": "exact", "hint": "h"}
+	], "final_message": "EGG"}`
+	_, warnings, err := Pack([]byte(raw), PackOptions{})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	var lost []string
+	for _, w := range warnings {
+		if strings.Contains(w, "near misses") {
+			lost = append(lost, w)
+		}
+	}
+	if len(lost) != 1 || !strings.HasPrefix(lost[0], "question 1:") {
+		t.Fatalf("expected one near-miss warning for question 1, got %v", warnings)
+	}
+
+	hidden := strings.Replace(raw, `"final_message"`, `"hide_near_miss": true, "final_message"`, 1)
+	_, warnings, err = Pack([]byte(hidden), PackOptions{})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if strings.Contains(strings.Join(warnings, "\n"), "near misses") {
+		t.Fatalf("hidden near misses must not warn, got %v", warnings)
+	}
+}
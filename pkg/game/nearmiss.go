@@ -0,0 +1,49 @@
+package game
+
+// FindNearMiss reports whether input is one typo beyond the tolerance of one
+// of q's fuzzy answers, and returns the closest such result. Hashed answers
+// can't be measured and never count as near misses.
+func FindNearMiss(input string, q Question) (CheckResult, bool) {
+	var best CheckResult
+	found := false
+	for _, a := range q.AcceptedAnswers() {
+		if a.Hashed() {
+			continue
+		}
+		checker, err := checkerFor(q, a)
+		if err != nil {
+			continue
+		}
+		fuzzy, ok := checker.(fuzzyChecker)
+		if !ok {
+			continue
+		}
+		r := fuzzy.n.CheckAnswer(input, a.Text)
+		if r.Outcome == NearMiss && (!found || r.Distance < best.Distance) {
+			best, found = r, true
+		}
+	}
+	return best, found
+}
+
+// reportsNearMisses reports whether FindNearMiss can call a guess at q, or
+// at one of its parts, close: it needs a plaintext fuzzy answer longer than
+// three characters.
+func (q Question) reportsNearMisses() bool {
+	questions := []Question{q}
+	for i := range q.Parts {
+		questions = append(questions, q.PartQuestion(i))
+	}
+	for _, pq := range questions {
+		for _, a := range pq.AcceptedAnswers() {
+			if a.Hashed() {
+				continue
+			}
+			checker, err := checkerFor(pq, a)
+			if _, fuzzy := checker.(fuzzyChecker); err == nil && fuzzy && len([]rune(NormalizeString(a.Text))) > 3 {
+				return true
+			}
+		}
+	}
+	return false
+}
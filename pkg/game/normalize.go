@@ -0,0 +1,166 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization stages a question (or the whole pack) can add with
+// "normalize". They are also the Form names of CheckResult. See also
+// NormalizeNumbers.
+const (
+	NormalizeFold   = "fold"
+	NormalizeGerman = "german"
+)
+
+// Normalizer is the answer normalization pipeline of a question. Input and
+// answers are always compared under the legacy and the enhanced
+// normalization; every enabled stage adds one more form to compare under.
+type Normalizer struct {
+	// Fold decomposes letters (NFKD) and drops the accents, and expands ß, æ
+	// and œ, so "café" matches "cafe" and "Straße" matches "strasse".
+	Fold bool
+	// German transliterates ä, ö and ü to ae, oe and ue, so "Müller" also
+	// matches "Mueller".
+	German bool
+	// Numbers compares input and answer by value when both are numbers (see
+	// NormalizeNumbers). Numbers are never matched with typos.
+	Numbers bool
+}
+
+// ParseNormalizer builds a Normalizer from stage names.
+func ParseNormalizer(stages []string) (Normalizer, error) {
+	var n Normalizer
+	for _, stage := range stages {
+		switch stage {
+		case NormalizeFold:
+			n.Fold = true
+		case NormalizeGerman:
+			n.German = true
+		case NormalizeNumbers:
+			n.Numbers = true
+		default:
+			return Normalizer{}, fmt.Errorf("unknown normalization stage %q", stage)
+		}
+	}
+	return n, nil
+}
+
+// form is one normalized version of a string and the name of its stage.
+// Exact forms are never compared with typo tolerance.
+type form struct {
+	name, text string
+	exact      bool
+}
+
+// forms returns the normalized versions of s, in a fixed order for every
+// input so that they can be compared pairwise.
+func (n Normalizer) forms(s string) []form {
+	forms := []form{
+		{FormLegacy, NormalizeString(s), false},
+		{FormEnhanced, normalizeEnhanced(s), false},
+	}
+	if n.Fold {
+		forms = append(forms, form{NormalizeFold, normalizeEnhanced(foldText(s)), false})
+	}
+	if n.German {
+		// Transliterate first so the fold doesn't just strip the umlauts.
+		g := germanText(s)
+		if n.Fold {
+			g = foldText(g)
+		}
+		forms = append(forms, form{NormalizeGerman, normalizeEnhanced(g), false})
+	}
+	if n.Numbers {
+		forms = append(forms, form{NormalizeNumbers, numericForm(s), true})
+	}
+	return forms
+}
+
+// key is the single most normalized form of s, for checkers that need one.
+func (n Normalizer) key(s string) string {
+	if n.Numbers {
+		if number := numericForm(s); number != "" {
+			return number
+		}
+	}
+	if n.German {
+		s = germanText(s)
+	}
+	if n.Fold {
+		s = foldText(s)
+	}
+	return normalizeEnhanced(s)
+}
+
+// hashForms returns the distinct non-empty forms of s, legacy first.
+func (n Normalizer) hashForms(s string) []string {
+	var out []string
+	for i, f := range n.forms(s) {
+		if i > 0 && f.text == "" {
+			continue
+		}
+		dup := false
+		for _, seen := range out {
+			dup = dup || seen == f.text
+		}
+		if !dup {
+			out = append(out, f.text)
+		}
+	}
+	return out
+}
+
+// foldExpansions covers letters that have no Unicode decomposition.
+var foldExpansions = strings.NewReplacer(
+	"ß", "ss", "ẞ", "ss",
+	"æ", "ae", "Æ", "ae",
+	"œ", "oe", "Œ", "oe",
+	"ø", "o", "Ø", "o",
+	"ł", "l", "Ł", "l",
+	"đ", "d", "Đ", "d",
+	"þ", "th", "Þ", "th",
+)
+
+// foldText decomposes s (NFKD, which also splits ligatures such as "ﬁ"),
+// drops combining marks and expands the letters in foldExpansions.
+func foldText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range norm.NFKD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return foldExpansions.Replace(b.String())
+}
+
+var germanTransliterations = strings.NewReplacer(
+	"ä", "ae", "Ä", "ae",
+	"ö", "oe", "Ö", "oe",
+	"ü", "ue", "Ü", "ue",
+	"ß", "ss", "ẞ", "ss",
+)
+
+// germanText transliterates umlauts, composing them first (NFC) so that a
+// decomposed "u" + "¨" is caught too.
+func germanText(s string) string {
+	return germanTransliterations.Replace(norm.NFC.String(s))
+}
+
+// inheritNormalize gives every question without stages of its own the
+// pack's.
+func (c *Config) inheritNormalize() {
+	if len(c.Normalize) == 0 {
+		return
+	}
+	for i := range c.Questions {
+		if c.Questions[i].Normalize == nil {
+			c.Questions[i].Normalize = append([]string(nil), c.Normalize...)
+		}
+	}
+}
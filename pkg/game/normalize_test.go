@@ -0,0 +1,91 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizerStages(t *testing.T) {
+	fold := Normalizer{Fold: true}
+	both := Normalizer{Fold: true, German: true}
+
+	tests := []struct {
+		n              Normalizer
+		input, correct string
+		want           bool
+	}{
+		{Normalizer{}, "cafe", "café", true}, // one typo, length 4
+		{Normalizer{}, "ole", "olé", false},  // short answers stay strict
+		{fold, "ole", "olé", true},
+		{fold, "OLÉ", "olé", true}, // decomposed input
+		{fold, "strasse", "Straße", true},
+		{fold, "STRASSE", "straße", true},
+		{fold, "aesir", "Æsir", true},
+		{fold, "oeuvre", "œuvre", true},
+		{fold, "office", "oﬃce", true}, // ligature
+		{fold, "ol", "öl", true},
+		{fold, "oel", "öl", false},
+		{both, "oel", "öl", true},
+		{both, "ol", "öl", true},
+		{both, "ÖL", "oel", true},
+		{both, "o\u0308l", "oel", true}, // decomposed umlaut
+		{both, "al", "öl", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.n.CheckAnswer(tt.input, tt.correct).Matched(); got != tt.want {
+			t.Errorf("%+v.CheckAnswer(%q, %q) = %v, want %v", tt.n, tt.input, tt.correct, got, tt.want)
+		}
+	}
+
+	if r := both.CheckAnswer("oel", "öl"); r.Form != NormalizeGerman || r.Distance != 0 {
+		t.Errorf("expected a german match, got %+v", r)
+	}
+}
+
+func TestNormalizeStagesOnQuestions(t *testing.T) {
+	raw := `{
+		"normalize": ["fold"],
+		"questions": [
+			{"id": 1, "text": "Q1", "answer": "Straße", "hint": "h"},
+			{"id": 2, "text": "Q2", "answer": "Müll", "hint": "h", "normalize": ["fold", "german"]},
+			{"id": 3, "text": "Q3", "answer": "Öl", "hint": "h", "normalize": []},
+			{"id": 4, "text": "Q4", "answer": "Zürich Genève", "check": "set", "hint": "h"}
+		],
+		"final_message": "EGG"
+	}`
+	packed, _, err := Pack([]byte(raw), PackOptions{})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if strings.Contains(string(packed), "Straße") {
+		t.Fatalf("packed config leaks an answer")
+	}
+
+	for _, cfg := range []string{raw, string(packed)} {
+		loaded, err := ParseConfig([]byte(cfg))
+		if err != nil {
+			t.Fatalf("ParseConfig: %v", err)
+		}
+		for _, tt := range []struct {
+			index int
+			input string
+			want  bool
+		}{
+			{0, "strasse", true},
+			{1, "muell", true},
+			{1, "mull", true},
+			{2, "ol", false},
+			{2, "Öl", true},
+			{3, "geneve, zurich", true},
+		} {
+			if _, ok := MatchAnswer(tt.input, loaded.Questions[tt.index]); ok != tt.want {
+				t.Errorf("question %d: MatchAnswer(%q) = %v, want %v", tt.index+1, tt.input, ok, tt.want)
+			}
+		}
+	}
+
+	if _, err := ParseConfig([]byte(`{"normalize": ["klingon"], "questions": [{"id": 1, "text": "Q", "answer": "a"}]}`)); err == nil || !strings.Contains(err.Error(), `unknown normalization stage "klingon"`) {
+		t.Fatalf("expected an unknown stage error, got %v", err)
+	}
+}
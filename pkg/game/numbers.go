@@ -0,0 +1,243 @@
+package game
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NormalizeNumbers is the normalization stage that compares numbers by value:
+// "22", "twenty-two", "zweiundzwanzig" and "0x16" are all the same answer, as
+// are "5 min" and "300 seconds".
+const NormalizeNumbers = "numbers"
+
+// numberWords are the English and German number words. Scales multiply
+// everything before them; the rest is added up, which covers both "twenty
+// two" and "zweiundzwanzig".
+var numberWords = map[string]int{
+	"zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	"thirteen": 13, "fourteen": 14, "fifteen": 15, "sixteen": 16,
+	"seventeen": 17, "eighteen": 18, "nineteen": 19, "twenty": 20,
+	"thirty": 30, "forty": 40, "fifty": 50, "sixty": 60, "seventy": 70,
+	"eighty": 80, "ninety": 90,
+
+	"null": 0, "ein": 1, "eins": 1, "eine": 1, "zwei": 2, "drei": 3, "vier": 4,
+	"fünf": 5, "fuenf": 5, "sechs": 6, "sieben": 7, "acht": 8, "neun": 9,
+	"zehn": 10, "elf": 11, "zwölf": 12, "zwoelf": 12, "dreizehn": 13,
+	"vierzehn": 14, "fünfzehn": 15, "fuenfzehn": 15, "sechzehn": 16,
+	"siebzehn": 17, "achtzehn": 18, "neunzehn": 19, "zwanzig": 20,
+	"dreißig": 30, "dreissig": 30, "vierzig": 40, "fünfzig": 50,
+	"fuenfzig": 50, "sechzig": 60, "siebzig": 70, "achtzig": 80, "neunzig": 90,
+}
+
+var numberScales = map[string]int{
+	"hundred": 100, "thousand": 1000, "million": 1000000, "billion": 1000000000,
+	"hundert": 100, "tausend": 1000, "millionen": 1000000, "milliarde": 1000000000, "milliarden": 1000000000,
+}
+
+// numberFillers may appear between number words.
+var numberFillers = map[string]bool{"and": true, "und": true}
+
+// unit is a unit of measurement: the canonical symbol of its dimension and
+// the factor to that base unit.
+type unit struct {
+	symbol string
+	factor float64
+}
+
+var units = map[string]unit{}
+
+func init() {
+	for symbol, group := range map[string]map[float64][]string{
+		"s": {
+			0.001: {"ms", "millisecond", "milliseconds", "millisekunde", "millisekunden"},
+			1:     {"s", "sec", "secs", "second", "seconds", "sek", "sekunde", "sekunden"},
+			60:    {"min", "mins", "minute", "minutes", "minuten"},
+			3600:  {"h", "hr", "hrs", "hour", "hours", "std", "stunde", "stunden"},
+			86400: {"d", "day", "days", "tag", "tage", "tagen"},
+		},
+		"B": {
+			1:       {"b", "byte", "bytes"},
+			1000:    {"kb", "kilobyte", "kilobytes"},
+			1 << 10: {"kib", "kibibyte", "kibibytes"},
+			1e6:     {"mb", "megabyte", "megabytes"},
+			1 << 20: {"mib", "mebibyte", "mebibytes"},
+			1e9:     {"gb", "gigabyte", "gigabytes"},
+			1 << 30: {"gib", "gibibyte", "gibibytes"},
+		},
+		"bit": {
+			1: {"bit", "bits"},
+		},
+		"%": {
+			1: {"%", "percent", "prozent"},
+		},
+	} {
+		for factor, names := range group {
+			for _, name := range names {
+				units[name] = unit{symbol, factor}
+			}
+		}
+	}
+
+	for _, words := range []map[string]int{numberWords, numberScales} {
+		for w := range words {
+			numberVocabulary = append(numberVocabulary, w)
+		}
+	}
+	for w := range numberFillers {
+		numberVocabulary = append(numberVocabulary, w)
+	}
+	sort.Slice(numberVocabulary, func(i, j int) bool {
+		a, b := numberVocabulary[i], numberVocabulary[j]
+		return len(a) > len(b) || (len(a) == len(b) && a < b)
+	})
+}
+
+var (
+	numberLiteral    = regexp.MustCompile(`^([-+]?)(0x[0-9a-f]+|0o[0-7]+|0b[01]+|[0-9]+(?:[.,][0-9]+)?)\s*(.*)$`)
+	thousandsLiteral = regexp.MustCompile(`^[0-9]{1,3}(?:,[0-9]{3})+$`)
+)
+
+// numericForm returns s as a canonical number with its unit converted to
+// the base unit, or "" if s isn't a number.
+func numericForm(s string) string {
+	value, symbol, ok := parseQuantity(s)
+	if !ok {
+		return ""
+	}
+	value = math.Round(value*1e9) / 1e9
+	return strconv.FormatFloat(value, 'f', -1, 64) + symbol
+}
+
+// parseQuantity reads a number, written in digits or words, followed by an
+// optional unit.
+func parseQuantity(s string) (value float64, symbol string, ok bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return 0, "", false
+	}
+
+	if m := numberLiteral.FindStringSubmatch(s); m != nil {
+		value, ok = parseLiteral(m[2])
+		if !ok {
+			return 0, "", false
+		}
+		if m[1] == "-" {
+			value = -value
+		}
+		return applyUnit(value, m[3])
+	}
+
+	words := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == '-' || r == '\t' })
+	if n, ok := parseNumberWords(words); ok {
+		return float64(n), "", true
+	}
+	if len(words) > 1 {
+		if n, ok := parseNumberWords(words[:len(words)-1]); ok {
+			return applyUnit(float64(n), words[len(words)-1])
+		}
+	}
+	return 0, "", false
+}
+
+func parseLiteral(s string) (float64, bool) {
+	for _, base := range []struct {
+		prefix string
+		base   int
+	}{{"0x", 16}, {"0o", 8}, {"0b", 2}} {
+		if strings.HasPrefix(s, base.prefix) {
+			n, err := strconv.ParseUint(s[2:], base.base, 64)
+			return float64(n), err == nil
+		}
+	}
+	if thousandsLiteral.MatchString(s) {
+		s = strings.ReplaceAll(s, ",", "")
+	}
+	n, err := parseNumber(s)
+	return n, err == nil
+}
+
+func applyUnit(value float64, name string) (float64, string, bool) {
+	name = strings.TrimRight(strings.TrimSpace(name), ".")
+	if name == "" {
+		return value, "", true
+	}
+	u, ok := units[name]
+	if !ok {
+		return 0, "", false
+	}
+	return value * u.factor, u.symbol, true
+}
+
+// parseNumberWords reads number words such as "two hundred and five" or
+// "zweihundertfünf". German compounds are split into their words first.
+func parseNumberWords(words []string) (int, bool) {
+	var tokens []string
+	for _, w := range words {
+		split, ok := splitNumberWord(w)
+		if !ok {
+			return 0, false
+		}
+		tokens = append(tokens, split...)
+	}
+
+	total, group := 0, 0
+	// Within a group, at most one of units (1-19) and tens, so "two two"
+	// isn't four.
+	var ones, tens, seen bool
+	for _, t := range tokens {
+		if numberFillers[t] {
+			continue
+		}
+		seen = true
+		if scale, ok := numberScales[t]; ok {
+			if group == 0 {
+				group = 1
+			}
+			if scale == 100 {
+				group *= 100
+				ones, tens = false, false
+				continue
+			}
+			total += group * scale
+			group, ones, tens = 0, false, false
+			continue
+		}
+		n := numberWords[t]
+		switch {
+		case n >= 20:
+			if tens || (ones && group%100 >= 10) {
+				return 0, false
+			}
+			tens = true
+		default:
+			if ones || (tens && n >= 10) {
+				return 0, false
+			}
+			ones = true
+		}
+		group += n
+	}
+	return total + group, seen
+}
+
+// numberVocabulary is every number word, scale and filler, longest first.
+var numberVocabulary []string
+
+// splitNumberWord splits w into number words, longest match first.
+func splitNumberWord(w string) ([]string, bool) {
+	var out []string
+	for w != "" {
+		i := slices.IndexFunc(numberVocabulary, func(token string) bool { return strings.HasPrefix(w, token) })
+		if i < 0 {
+			return nil, false
+		}
+		out = append(out, numberVocabulary[i])
+		w = w[len(numberVocabulary[i]):]
+	}
+	return out, true
+}
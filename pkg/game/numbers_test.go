@@ -0,0 +1,95 @@
+package game
+
+import "testing"
+
+func TestNumericForm(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"22", "22"},
+		{" 22.0 ", "22"},
+		{"3,5", "3.5"},
+		{"1,000", "1000"},
+		{"-7", "-7"},
+		{"0x16", "22"},
+		{"0o26", "22"},
+		{"0b10110", "22"},
+		{"twenty-two", "22"},
+		{"Twenty Two", "22"},
+		{"two hundred and five", "205"},
+		{"one thousand nine hundred eighty-four", "1984"},
+		{"zweiundzwanzig", "22"},
+		{"Zweihundertfünf", "205"},
+		{"neunzehnhundert", "1900"},
+		{"dreitausend", "3000"},
+		{"5 min", "300s"},
+		{"5 minutes", "300s"},
+		{"300 seconds", "300s"},
+		{"fünf Minuten", "300s"},
+		{"1.5h", "5400s"},
+		{"2 KiB", "2048B"},
+		{"50%", "50%"},
+		{"fifty percent", "50%"},
+
+		{"", ""},
+		{"two two", ""},
+		{"twenty twelve", ""},
+		{"and", ""},
+		{"5 parsecs", ""},
+		{"often", ""},
+		{"0x", ""},
+		{"port 22", ""},
+	}
+
+	for _, tt := range tests {
+		if got := numericForm(tt.input); got != tt.want {
+			t.Errorf("numericForm(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNumbersStage(t *testing.T) {
+	n := Normalizer{Numbers: true}
+	tests := []struct {
+		input, correct string
+		want           bool
+	}{
+		{"twenty two", "22", true},
+		{"0x16", "twenty-two", true},
+		{"22", "zweiundzwanzig", true},
+		{"5 minutes", "5 min", true},
+		{"1234568", "1234567", false}, // numbers get no typo tolerance
+		{"5 min", "5", false},
+		{"twenty-too", "twenty-two", true}, // not a number: fuzzy fallback
+		{"keyboard", "keyboard", true},
+	}
+	for _, tt := range tests {
+		if got := n.CheckAnswer(tt.input, tt.correct).Matched(); got != tt.want {
+			t.Errorf("CheckAnswer(%q, %q) = %v, want %v", tt.input, tt.correct, got, tt.want)
+		}
+	}
+	if r := n.CheckAnswer("0x16", "22"); r.Form != NormalizeNumbers {
+		t.Errorf("expected a numeric match, got %+v", r)
+	}
+	if !CheckAnswer("1234568", "1234567").Matched() {
+		t.Errorf("without the stage numbers still tolerate typos")
+	}
+
+	q := Question{Answer: "twenty-two", Normalize: []string{NormalizeNumbers}}
+	if _, err := q.HashAnswers(); err != nil {
+		t.Fatalf("HashAnswers: %v", err)
+	}
+	for _, input := range []string{"22", "0x16", "Twenty Two", "zweiundzwanzig"} {
+		if _, ok := MatchAnswer(input, q); !ok {
+			t.Errorf("hashed answer should accept %q", input)
+		}
+	}
+	if _, ok := MatchAnswer("23", q); ok {
+		t.Errorf("hashed answer accepted 23")
+	}
+
+	set := Question{Answer: "22 80 443", Check: CheckSet, Normalize: []string{NormalizeNumbers}}
+	if _, ok := MatchAnswer("0x50, 443, twenty-two", set); !ok {
+		t.Errorf("set answers should compare numbers by value")
+	}
+}
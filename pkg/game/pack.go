@@ -0,0 +1,89 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PackOptions controls how Pack protects a question pack.
+type PackOptions struct {
+	// Plaintext keeps answers readable (useful while iterating on a pack).
+	Plaintext bool
+	// Chain encrypts each question, and the finale, under the previous answer.
+	Chain bool
+}
+
+// Pack validates a plain JSON question pack and returns it ready for
+// shipping: answers replaced by salted hashes of their normalized forms and,
+// with Chain set, questions after the first encrypted under keys derived from
+// the previous answer. Warnings name the parts that stay readable.
+func Pack(raw []byte, opts PackOptions) (packed []byte, warnings []string, err error) {
+	var config Config
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, nil, fmt.Errorf("parsing pack: %w", err)
+	}
+	config.inheritNormalize()
+	if err := config.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if opts.Plaintext {
+		return raw, nil, nil
+	}
+	// Questions carry their stages from here on; one that opted out with an
+	// empty list must not inherit them again when the pack is loaded.
+	config.Normalize = nil
+
+	config.AssignLevels()
+	config.LevelSecret = ""
+
+	// Chaining seals each question under the one before it, which only works
+	// for a fixed order.
+	var kind string
+	switch {
+	case config.IsGraph():
+		kind = "question graph"
+	case config.IsBoard():
+		kind = "category board"
+	case config.Randomized():
+		kind = "randomized pack"
+	case config.HasParts():
+		kind = "multi-part questions"
+	}
+	if opts.Chain && kind != "" {
+		warnings = append(warnings, kind+": chaining is not supported, questions are stored readable")
+		opts.Chain = false
+	}
+	if opts.Chain {
+		chainWarnings, err := config.SealChain()
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, chainWarnings...)
+	}
+
+	if config.LevelCodes && !opts.Chain {
+		// Only chaining turns the codes into keys; otherwise they are
+		// stored with their questions.
+		warnings = append(warnings, "level codes are stored readable; chain the pack to seal them")
+	}
+	if config.FlagSecret != "" {
+		// The game renders flags itself, so the secret has to ship with it.
+		warnings = append(warnings, "flag_secret is stored readable; chain the pack to seal it with the finale")
+	}
+
+	for i := range config.Questions {
+		q := &config.Questions[i]
+		nearMiss := !config.HideNearMiss && q.reportsNearMisses()
+		hashWarnings, err := q.HashAnswers()
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, hashWarnings...)
+		if nearMiss && !q.reportsNearMisses() {
+			warnings = append(warnings, fmt.Sprintf("question %d: hashed answers never report near misses; pack with -plaintext or set hide_near_miss", q.ID))
+		}
+	}
+
+	packed, err = json.Marshal(&config)
+	return packed, warnings, err
+}
@@ -0,0 +1,68 @@
+package game
+
+import "fmt"
+
+// Part is one named answer field of a multi-part question, such as the
+// "username" and "port" of a login. Each part has its own answers and
+// checker; the question is solved once every part is.
+type Part struct {
+	Name    string   `json:"name"`
+	Answers []Answer `json:"answers"`
+	Check   string   `json:"check,omitempty"`
+}
+
+// PartQuestion returns q reduced to part i, so the part is matched and
+// hashed like a question of its own with q's salt and normalization.
+func (q Question) PartQuestion(i int) Question {
+	p := q.Parts[i]
+	return Question{ID: q.ID, Answers: p.Answers, Check: p.Check, Normalize: q.Normalize, Salt: q.Salt}
+}
+
+// MatchPart checks input against part i of q.
+func MatchPart(input string, q Question, i int) (Answer, bool) {
+	return MatchAnswer(input, q.PartQuestion(i))
+}
+
+// HasParts reports whether any question of the pack has parts.
+func (c *Config) HasParts() bool {
+	for _, q := range c.Questions {
+		if len(q.Parts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func validateParts(q Question) error {
+	if len(q.AcceptedAnswers()) > 0 {
+		return fmt.Errorf("question %d: answers and parts can't be combined", q.ID)
+	}
+	if len(q.Responses) > 0 {
+		return fmt.Errorf("question %d: responses can't be combined with parts", q.ID)
+	}
+	if len(q.Choices) > 0 {
+		return fmt.Errorf("question %d: choices and parts can't be combined", q.ID)
+	}
+	seen := make(map[string]bool)
+	for i, p := range q.Parts {
+		if p.Name == "" {
+			return fmt.Errorf("question %d: part %d has no name", q.ID, i+1)
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("question %d: duplicate part %q", q.ID, p.Name)
+		}
+		seen[p.Name] = true
+
+		pq := q.PartQuestion(i)
+		answers := pq.AcceptedAnswers()
+		if len(answers) == 0 {
+			return fmt.Errorf("question %d: part %q has no accepted answers", q.ID, p.Name)
+		}
+		for _, a := range answers {
+			if err := validateAnswer(pq, a); err != nil {
+				return fmt.Errorf("question %d: part %q: %w", q.ID, p.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const partsPack = `{
+	"questions": [
+		{"id": 1, "text": "Who logged in, and where?", "hint": "h", "parts": [
+			{"name": "user", "answers": ["administrator"]},
+			{"name": "port", "answers": ["22"], "check": "exact"}
+		]}
+	],
+	"final_message": "EGG"
+}`
+
+func TestMatchPart(t *testing.T) {
+	var cfg Config
+	if err := json.Unmarshal([]byte(partsPack), &cfg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	q := cfg.Questions[0]
+
+	if _, ok := MatchPart("Administrater", q, 0); !ok {
+		t.Errorf("fuzzy part should accept a typo")
+	}
+	if _, ok := MatchPart("22", q, 0); ok {
+		t.Errorf("parts must not accept each other's answers")
+	}
+	if _, ok := MatchPart("22 ", q, 1); !ok {
+		t.Errorf("exact part should accept its answer")
+	}
+	if _, ok := MatchPart("23", q, 1); ok {
+		t.Errorf("exact part must not accept a typo")
+	}
+	if _, ok := MatchAnswer("administrator", q); ok {
+		t.Errorf("a multi-part question has no answer of its own")
+	}
+}
+
+func TestPackHashesParts(t *testing.T) {
+	packed, warnings, err := Pack([]byte(partsPack), PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if strings.Contains(string(packed), "administrator") {
+		t.Fatalf("packed config leaks a part answer")
+	}
+	if !strings.Contains(strings.Join(warnings, "\n"), "multi-part questions") {
+		t.Errorf("expected chaining to be disabled, warnings %v", warnings)
+	}
+
+	cfg, err := ParseConfig(packed)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	if _, ok := MatchPart("administrator", cfg.Questions[0], 0); !ok {
+		t.Errorf("hashed part not matched")
+	}
+	if _, ok := MatchPart("22", cfg.Questions[0], 1); !ok {
+		t.Errorf("hashed exact part not matched")
+	}
+}
+
+func TestValidateParts(t *testing.T) {
+	user := Part{Name: "user", Answers: []Answer{{Text: "admin"}}}
+	for _, tt := range []struct {
+		q    Question
+		want string
+	}{
+		{Question{Answer: "a", Parts: []Part{user}}, "can't be combined"},
+		{Question{Choices: []string{"a", "b"}, Parts: []Part{user}}, "can't be combined"},
+		{Question{Parts: []Part{{Answers: user.Answers}}}, "has no name"},
+		{Question{Parts: []Part{user, user}}, "duplicate part"},
+		{Question{Parts: []Part{{Name: "port"}}}, "no accepted answers"},
+		{Question{Parts: []Part{{Name: "port", Answers: user.Answers, Check: "telepathy"}}}, "unknown answer checker"},
+	} {
+		tt.q.ID, tt.q.Text = 1, "Q"
+		err := validateParts(tt.q)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("validateParts(%+v) = %v, want %q", tt.q.Parts, err, tt.want)
+		}
+	}
+}
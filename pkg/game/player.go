@@ -0,0 +1,47 @@
+package game
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+// MaxPlayerName is the longest player or team name kept, in runes.
+const MaxPlayerName = 32
+
+// NewPlayerID returns a random id for players who did not give one, so
+// anonymous players still get flags of their own.
+func NewPlayerID() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "anonymous"
+	}
+	return "anon-" + hex.EncodeToString(b)
+}
+
+// CleanPlayerName makes a typed or forwarded name safe to show and to put in
+// flags: control and other unprintable characters are dropped, runs of
+// spaces collapsed, and the result cut to MaxPlayerName runes.
+func CleanPlayerName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r):
+			b.WriteRune(' ')
+		case unicode.IsPrint(r):
+			b.WriteRune(r)
+		}
+	}
+	clean := strings.Join(strings.Fields(b.String()), " ")
+	if runes := []rune(clean); len(runes) > MaxPlayerName {
+		clean = strings.TrimSpace(string(runes[:MaxPlayerName]))
+	}
+	return clean
+}
+
+// FillPlayer replaces {{player}} in question text, hints, and the final
+// message with the player's name.
+func FillPlayer(text, name string) string {
+	return strings.ReplaceAll(text, "{{player}}", name)
+}
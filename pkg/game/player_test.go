@@ -0,0 +1,26 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCleanPlayerName(t *testing.T) {
+	for in, want := range map[string]string{
+		"  Team   Rocket ":      "Team Rocket",
+		"evil\x1b[31mname\n":    "evil[31mname",
+		"":                      "",
+		strings.Repeat("x", 40): strings.Repeat("x", MaxPlayerName),
+		"ünïcødé\tcrew\u200b":   "ünïcødé crew",
+	} {
+		if got := CleanPlayerName(in); got != want {
+			t.Errorf("CleanPlayerName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFillPlayer(t *testing.T) {
+	if got := FillPlayer("Welcome, {{player}}. {{player}}!", "Ada"); got != "Welcome, Ada. Ada!" {
+		t.Fatalf("FillPlayer = %q", got)
+	}
+}
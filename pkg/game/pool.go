@@ -0,0 +1,99 @@
+package game
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+)
+
+// Pool draws Pick of the questions whose pool is Name for every player.
+type Pool struct {
+	Name string `json:"name"`
+	Pick int    `json:"pick"`
+}
+
+// Randomized reports whether players get different questions or a different
+// order depending on their seed (see Config.Sample).
+func (c *Config) Randomized() bool {
+	return len(c.Pools) > 0 || c.Shuffle
+}
+
+// Sample returns a copy of the pack as one player sees it: Pick questions
+// drawn from every pool, the others dropped, and with Shuffle set the
+// questions in random order. Questions outside pools are always kept. The
+// same seed always gives the same questions, so a run can be reproduced.
+func (c *Config) Sample(seed int64) *Config {
+	r := rand.New(rand.NewSource(seed))
+
+	drawn := make(map[int]bool)
+	for _, pool := range c.Pools {
+		var members []int
+		for i, q := range c.Questions {
+			if q.Pool == pool.Name {
+				members = append(members, i)
+			}
+		}
+		r.Shuffle(len(members), func(a, b int) { members[a], members[b] = members[b], members[a] })
+		for _, i := range members[:min(pool.Pick, len(members))] {
+			drawn[i] = true
+		}
+	}
+
+	out := *c
+	out.Questions = nil
+	for i, q := range c.Questions {
+		if q.Pool == "" || drawn[i] {
+			out.Questions = append(out.Questions, q)
+		}
+	}
+	if c.Shuffle {
+		r.Shuffle(len(out.Questions), func(a, b int) {
+			out.Questions[a], out.Questions[b] = out.Questions[b], out.Questions[a]
+		})
+	}
+	return &out
+}
+
+// SessionSeed derives the sampling seed for a player or session id, so the
+// same player gets the same questions every time they play this pack.
+func SessionSeed(pack, id string) int64 {
+	sum := sha256.Sum256([]byte("ctf-tool/egg seed/" + pack + "/" + id))
+	return int64(binary.BigEndian.Uint64(sum[:8]) >> 1)
+}
+
+func validatePools(c *Config) error {
+	if c.Randomized() && c.IsGraph() {
+		return fmt.Errorf("pools and shuffle cannot be used in a question graph")
+	}
+	picks := make(map[string]int)
+	for _, pool := range c.Pools {
+		if pool.Name == "" {
+			return fmt.Errorf("pool without a name")
+		}
+		if _, dup := picks[pool.Name]; dup {
+			return fmt.Errorf("pool %q is defined twice", pool.Name)
+		}
+		if pool.Pick < 1 {
+			return fmt.Errorf("pool %q: pick must be at least 1", pool.Name)
+		}
+		picks[pool.Name] = pool.Pick
+	}
+
+	members := make(map[string]int)
+	for _, q := range c.Questions {
+		if q.Pool == "" {
+			continue
+		}
+		if _, ok := picks[q.Pool]; !ok {
+			return fmt.Errorf("question %d: unknown pool %q", q.ID, q.Pool)
+		}
+		members[q.Pool]++
+	}
+	for _, pool := range c.Pools {
+		if members[pool.Name] < pool.Pick {
+			return fmt.Errorf("pool %q: pick %d but only %d questions", pool.Name, pool.Pick, members[pool.Name])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func poolPack() []byte {
+	return []byte(`{
+		"pools": [{"name": "warmup", "pick": 2}],
+		"shuffle": true,
+		"questions": [
+			{"id": 1, "text": "Q1", "answers": ["a"], "pool": "warmup"},
+			{"id": 2, "text": "Q2", "answers": ["b"], "pool": "warmup"},
+			{"id": 3, "text": "Q3", "answers": ["c"], "pool": "warmup"},
+			{"id": 4, "text": "Q4", "answers": ["d"], "pool": "warmup"},
+			{"id": 5, "text": "Q5", "answers": ["e"]}
+		],
+		"final_message": "EGG"
+	}`)
+}
+
+func sampledIDs(c *Config) []int {
+	var ids []int
+	for _, q := range c.Questions {
+		ids = append(ids, q.ID)
+	}
+	return ids
+}
+
+func TestSampleDrawsFromPools(t *testing.T) {
+	cfg, err := ParseConfig(poolPack())
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+
+	draws := make(map[string]bool)
+	for seed := int64(1); seed <= 20; seed++ {
+		ids := sampledIDs(cfg.Sample(seed))
+		if len(ids) != 3 {
+			t.Fatalf("seed %d: expected 2 pool questions and the fixed one, got %v", seed, ids)
+		}
+		found := false
+		for _, id := range ids {
+			found = found || id == 5
+		}
+		if !found {
+			t.Fatalf("seed %d: question outside the pool was dropped: %v", seed, ids)
+		}
+		if !reflect.DeepEqual(ids, sampledIDs(cfg.Sample(seed))) {
+			t.Fatalf("seed %d: the same seed must give the same draw", seed)
+		}
+		draws[fmt.Sprint(ids)] = true
+	}
+	if len(cfg.Questions) != 5 {
+		t.Fatalf("Sample must not modify the pack")
+	}
+	if len(draws) < 2 {
+		t.Fatalf("different seeds should give different draws")
+	}
+}
+
+func TestSessionSeed(t *testing.T) {
+	if SessionSeed("pack", "alice") != SessionSeed("pack", "alice") {
+		t.Fatalf("seeds must be stable")
+	}
+	if SessionSeed("pack", "alice") == SessionSeed("pack", "bob") || SessionSeed("pack", "alice") == SessionSeed("other", "alice") {
+		t.Fatalf("seeds must depend on the pack and the id")
+	}
+	if SessionSeed("pack", "alice") < 0 {
+		t.Fatalf("seeds must not be negative")
+	}
+}
+
+func TestValidatePools(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		pack string
+		want string
+	}{
+		{"unknown pool", `{"questions": [{"id": 1, "text": "Q", "answers": ["a"], "pool": "x"}]}`, `unknown pool "x"`},
+		{"pick too large", `{"pools": [{"name": "x", "pick": 2}], "questions": [{"id": 1, "text": "Q", "answers": ["a"], "pool": "x"}]}`, "only 1 questions"},
+		{"graph", `{"shuffle": true, "questions": [{"id": 1, "text": "Q", "answers": ["a"]}, {"id": 2, "text": "Q", "answers": ["a"], "requires": [1]}]}`, "question graph"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.pack))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package game
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"time"
+)
+
+// ErrTamperedProgress is returned when a progress file fails its MAC check or
+// belongs to a different pack.
+var ErrTamperedProgress = errors.New("saved progress has been modified")
+
+// progressVersion is bumped when the Progress layout changes incompatibly.
+const progressVersion = 1
+
+// Progress is a player's saved position in a pack.
+type Progress struct {
+	Version int    `json:"version"`
+	Pack    string `json:"pack"`
+
+	QuestionIndex int `json:"question_index"`
+	WrongAnswers  int `json:"wrong_answers"`
+	HintsRevealed int `json:"hints_revealed"`
+
+	// Elapsed times, so timers and time bonuses continue where they stopped.
+	QuestionElapsed time.Duration `json:"question_elapsed"`
+	GameElapsed     time.Duration `json:"game_elapsed"`
+
+	Results   []QuestionResult `json:"results,omitempty"`
+	Penalties []Penalty        `json:"penalties,omitempty"`
+
+	// Inputs holds the accepted input of every solved question of a chained
+	// pack, replayed through Config.Unlock on resume.
+	Inputs []string `json:"inputs,omitempty"`
+
+	// Level is the level code the game was started from. A chained pack
+	// needs it to open that question again before replaying Inputs.
+	Level string `json:"level,omitempty"`
+
+	// Parts holds, per part of a multi-part current question, the input
+	// that solved it, or "" for a part still open.
+	Parts []string `json:"parts,omitempty"`
+
+	// Trail is the way through a question graph so far.
+	Trail Trail `json:"trail"`
+
+	// Lockout keeps a running cooldown across restarts.
+	Lockout Lockout `json:"lockout"`
+
+	// Seed is the draw of a randomized pack (see Config.Sample).
+	Seed int64 `json:"seed,omitempty"`
+
+	// Player is the name the player gave, and AnonID the id their final
+	// flag is rendered for without one.
+	Player string `json:"player,omitempty"`
+	AnonID string `json:"anon_id,omitempty"`
+
+	Finished bool      `json:"finished,omitempty"`
+	TimedOut bool      `json:"timed_out,omitempty"`
+	SavedAt  time.Time `json:"saved_at"`
+}
+
+// progressFile is the on-disk envelope: the progress JSON and its MAC.
+type progressFile struct {
+	Progress json.RawMessage `json:"progress"`
+	MAC      string          `json:"mac"`
+}
+
+// Fingerprint identifies a pack as loaded, before any question is unlocked.
+// Progress is only restored into the pack it was saved from.
+func (c *Config) Fingerprint() string {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// progressKey is baked into every build, like the trailer key. The MAC makes
+// hand-edited state files detectable; it does not stop someone who reverse
+// engineers the binary.
+func progressKey(pack string) []byte {
+	key := sha256.Sum256([]byte("ctf-tool/egg progress v1/" + pack))
+	return key[:]
+}
+
+func progressMAC(pack string, raw []byte) string {
+	mac := hmac.New(sha256.New, progressKey(pack))
+	mac.Write(raw)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SaveProgress writes p to path, creating the directory if needed. The file
+// is replaced atomically so a crash never leaves half a state file behind.
+func SaveProgress(path string, p Progress) error {
+	p.Version = progressVersion
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	out, err := json.Marshal(progressFile{Progress: raw, MAC: progressMAC(p.Pack, raw)})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadProgress reads the progress saved at path for the pack with the given
+// fingerprint. A missing file is reported with an error satisfying
+// errors.Is(err, fs.ErrNotExist).
+func LoadProgress(path, pack string) (Progress, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return Progress{}, err
+	}
+	var file progressFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return Progress{}, fmt.Errorf("%w: %v", ErrTamperedProgress, err)
+	}
+	if !hmac.Equal([]byte(progressMAC(pack, file.Progress)), []byte(file.MAC)) {
+		return Progress{}, ErrTamperedProgress
+	}
+
+	var p Progress
+	if err := json.Unmarshal(file.Progress, &p); err != nil {
+		return Progress{}, fmt.Errorf("%w: %v", ErrTamperedProgress, err)
+	}
+	if p.Pack != pack {
+		return Progress{}, ErrTamperedProgress
+	}
+	if p.Version != progressVersion {
+		return Progress{}, fmt.Errorf("unsupported progress version %d", p.Version)
+	}
+	return p, nil
+}
+
+var sessionPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{8,64}$`)
+
+// ValidSession reports whether token can be used as a web session key.
+func ValidSession(token string) bool {
+	return sessionPattern.MatchString(token)
+}
+
+// ProgressPath returns the state file for a pack, or for a web session when
+// session is set. Files live in $XDG_STATE_HOME/ctf-tool (~/.local/state on
+// Unix) or the user config directory elsewhere.
+func ProgressPath(pack, session string) (string, error) {
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	name := "progress-" + shortID(pack) + ".json"
+	if session != "" {
+		if !ValidSession(session) {
+			return "", fmt.Errorf("invalid session token %q", session)
+		}
+		name = "session-" + shortID(pack) + "-" + session + ".json"
+	}
+	return filepath.Join(dir, "ctf-tool", name), nil
+}
+
+func shortID(pack string) string {
+	if len(pack) > 12 {
+		return pack[:12]
+	}
+	return pack
+}
+
+func stateDir() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return dir, nil
+	}
+	if runtime.GOOS != "windows" && runtime.GOOS != "darwin" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(home, ".local", "state"), nil
+	}
+	return os.UserConfigDir()
+}
@@ -0,0 +1,77 @@
+package game
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "progress.json")
+	want := Progress{
+		Pack:            "abc123",
+		QuestionIndex:   2,
+		WrongAnswers:    1,
+		HintsRevealed:   1,
+		QuestionElapsed: 12 * time.Second,
+		GameElapsed:     3 * time.Minute,
+		Results:         []QuestionResult{{QuestionID: 1, Attempts: 1, Points: 140}},
+		Inputs:          []string{"echo", ""},
+	}
+	if err := SaveProgress(path, want); err != nil {
+		t.Fatalf("SaveProgress: %v", err)
+	}
+
+	got, err := LoadProgress(path, "abc123")
+	if err != nil {
+		t.Fatalf("LoadProgress: %v", err)
+	}
+	if got.QuestionIndex != 2 || got.GameElapsed != 3*time.Minute || len(got.Results) != 1 || got.Inputs[0] != "echo" {
+		t.Fatalf("unexpected progress %+v", got)
+	}
+
+	if _, err := LoadProgress(path, "other-pack"); !errors.Is(err, ErrTamperedProgress) {
+		t.Fatalf("expected progress of another pack to be rejected, got %v", err)
+	}
+}
+
+func TestLoadProgressDetectsTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress.json")
+	if err := SaveProgress(path, Progress{Pack: "abc123", QuestionIndex: 1}); err != nil {
+		t.Fatalf("SaveProgress: %v", err)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited := strings.Replace(string(raw), `"question_index":1`, `"question_index":4`, 1)
+	if edited == string(raw) {
+		t.Fatalf("test setup: question_index not found in %s", raw)
+	}
+	if err := os.WriteFile(path, []byte(edited), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadProgress(path, "abc123"); !errors.Is(err, ErrTamperedProgress) {
+		t.Fatalf("expected tampering to be detected, got %v", err)
+	}
+}
+
+func TestProgressPath(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/state")
+
+	path, err := ProgressPath("0123456789abcdef", "")
+	if err != nil || path != filepath.Join("/state", "ctf-tool", "progress-0123456789ab.json") {
+		t.Fatalf("got %q, %v", path, err)
+	}
+	path, err = ProgressPath("0123456789abcdef", "session_TOKEN-1")
+	if err != nil || path != filepath.Join("/state", "ctf-tool", "session-0123456789ab-session_TOKEN-1.json") {
+		t.Fatalf("got %q, %v", path, err)
+	}
+	if _, err := ProgressPath("0123456789abcdef", "../../etc/passwd"); err == nil {
+		t.Fatalf("expected path-like session token to be rejected")
+	}
+}
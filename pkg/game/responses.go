@@ -0,0 +1,54 @@
+package game
+
+import "fmt"
+
+// Response is the pack's reply to one specific wrong answer, matched like an
+// accepted answer:
+//
+//	"responses": [
+//	  {"answer": "md5", "reply": "Close, but think older."},
+//	  {"answer": {"text": "decoy", "match": "exact"}, "reply": "Nice try.", "penalty": 50}
+//	]
+//
+// A response still counts as a wrong answer. Penalty makes it a trap that
+// takes points off the player's score.
+type Response struct {
+	Answer  Answer `json:"answer"`
+	Reply   string `json:"reply"`
+	Penalty int    `json:"penalty,omitempty"`
+}
+
+// MatchResponse returns the first response of q whose answer matches input.
+// Callers check MatchAnswer first: accepted answers win over responses.
+func MatchResponse(input string, q Question) (Response, bool) {
+	return NewGuess(input, q).Response()
+}
+
+// Response returns the first response of the question the input matches.
+// Callers check Answer first: accepted answers win over responses.
+func (g *Guess) Response() (Response, bool) {
+	for _, r := range g.m.q.Responses {
+		if g.m.match(g.Input, r.Answer) {
+			return r, true
+		}
+	}
+	return Response{}, false
+}
+
+func validateResponses(q Question) error {
+	for _, r := range q.Responses {
+		if r.Answer.Text == "" && !r.Answer.Hashed() {
+			return fmt.Errorf("question %d: response without an answer", q.ID)
+		}
+		if r.Reply == "" {
+			return fmt.Errorf("question %d: response to %q has no reply", q.ID, r.Answer.Label())
+		}
+		if r.Penalty < 0 {
+			return fmt.Errorf("question %d: response to %q has a negative penalty", q.ID, r.Answer.Label())
+		}
+		if err := validateAnswer(q, r.Answer); err != nil {
+			return fmt.Errorf("question %d: response to %q: %w", q.ID, r.Answer.Label(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func responsePack(t *testing.T) *Config {
+	t.Helper()
+	raw := `{
+		"questions": [
+			{"id": 1, "text": "Which hash?", "answer": "md4", "hint": "h", "responses": [
+				{"answer": "md5", "reply": "Close, but think older."},
+				{"answer": {"text": "sha1", "match": "exact"}, "reply": "Nice try, that's the decoy.", "penalty": 30}
+			]},
+			{"id": 2, "text": "Which port?", "answer": "22", "hint": "h", "responses": [
+				{"answer": "23", "reply": "Telnet? Really?"}
+			]}
+		],
+		"final_message": "EGG"
+	}`
+	var cfg Config
+	if err := json.Unmarshal([]byte(raw), &cfg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	return &cfg
+}
+
+func TestMatchResponse(t *testing.T) {
+	q := responsePack(t).Questions[0]
+
+	if r, ok := MatchResponse(" MD-5 ", q); !ok || r.Reply != "Close, but think older." {
+		t.Fatalf("fuzzy response not matched: %+v %v", r, ok)
+	}
+	if r, ok := MatchResponse("SHA1", q); !ok || r.Penalty != 30 {
+		t.Fatalf("trap not matched: %+v %v", r, ok)
+	}
+	if _, ok := MatchResponse("shaa1", q); ok {
+		t.Fatalf("exact response must not match loosely")
+	}
+	if _, ok := MatchResponse("md4", q); ok {
+		t.Fatalf("the right answer has no response")
+	}
+}
+
+func TestResponsesSurviveHashingAndSealing(t *testing.T) {
+	cfg := responsePack(t)
+	if _, err := cfg.SealChain(); err != nil {
+		t.Fatalf("SealChain: %v", err)
+	}
+	for i := range cfg.Questions {
+		if _, err := cfg.Questions[i].HashAnswers(); err != nil {
+			t.Fatalf("HashAnswers: %v", err)
+		}
+	}
+	packed, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	for _, secret := range []string{"md5", "sha1", "Telnet"} {
+		if strings.Contains(string(packed), secret) {
+			t.Fatalf("packed config leaks %q", secret)
+		}
+	}
+
+	var loaded Config
+	if err := json.Unmarshal(packed, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if r, ok := MatchResponse("md5", loaded.Questions[0]); !ok || r.Reply != "Close, but think older." {
+		t.Fatalf("hashed response not matched: %+v %v", r, ok)
+	}
+	if err := loaded.Unlock(0, "md4"); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	if r, ok := MatchResponse("23", loaded.Questions[1]); !ok || r.Reply != "Telnet? Really?" {
+		t.Fatalf("sealed response not restored: %+v %v", r, ok)
+	}
+}
+
+func TestGuessHashesInputOnce(t *testing.T) {
+	q := responsePack(t).Questions[0]
+	if _, err := q.HashAnswers(); err != nil {
+		t.Fatalf("HashAnswers: %v", err)
+	}
+
+	g := NewGuess("md5", q)
+	if _, ok := g.Answer(); ok {
+		t.Fatalf("md5 is not the answer")
+	}
+	hashed := len(g.m.hashCache)
+	if hashed == 0 {
+		t.Fatalf("expected the hashed answer to hash the input")
+	}
+	if r, ok := g.Response(); !ok || r.Reply != "Close, but think older." {
+		t.Fatalf("hashed response not matched: %+v %v", r, ok)
+	}
+	if len(g.m.hashCache) != hashed {
+		t.Fatalf("responses hashed the input again: %d forms, then %d", hashed, len(g.m.hashCache))
+	}
+}
+
+func TestValidateResponses(t *testing.T) {
+	for _, tt := range []struct {
+		response Response
+		want     string
+	}{
+		{Response{Reply: "r"}, "response without an answer"},
+		{Response{Answer: Answer{Text: "x"}}, "has no reply"},
+		{Response{Answer: Answer{Text: "x"}, Reply: "r", Penalty: -1}, "negative penalty"},
+		{Response{Answer: Answer{Text: "x", Match: "telepathy"}, Reply: "r"}, "unknown answer checker"},
+	} {
+		q := Question{ID: 1, Text: "Q", Answer: "a", Responses: []Response{tt.response}}
+		err := validateResponses(q)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("validateResponses(%+v) = %v, want %q", tt.response, err, tt.want)
+		}
+	}
+}
+
+func TestLintFlagsShadowedResponse(t *testing.T) {
+	cfg := &Config{
+		Questions: []Question{{ID: 1, Text: "Q", Answer: "footsteps", Hint: "h", Responses: []Response{
+			{Answer: Answer{Text: "footstep"}, Reply: "So close."},
+		}}},
+		FinalMessage: "EGG",
+	}
+	issues := Lint(cfg)
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "never shown") {
+		t.Fatalf("expected a shadowed response warning, got %v", issues)
+	}
+}
+
+func TestScorecardPenalties(t *testing.T) {
+	s := NewScorecard(ScoreRules{Points: 100})
+	q := Question{ID: 1}
+	s.Record(q, 0, 0, 0)
+	s.Penalize(q, 30)
+	s.Penalize(q, 0)
+	if s.Total() != 70 || len(s.Penalties) != 1 {
+		t.Fatalf("Total = %d, penalties %+v", s.Total(), s.Penalties)
+	}
+	s.Penalize(q, 500)
+	if s.Total() != 0 {
+		t.Fatalf("Total must not go negative, got %d", s.Total())
+	}
+}
@@ -0,0 +1,174 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaVersion is the pack layout written by the packer. Packs without a
+// schema_version are treated as version 1 and migrated on load.
+const SchemaVersion = 3
+
+// Pack source formats, detected from the file extension.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+)
+
+// migration upgrades a decoded pack from version from to from+1.
+type migration struct {
+	from  int
+	apply func(doc map[string]any) error
+}
+
+var migrations = []migration{
+	{from: 1, apply: migrateV1Answers},
+	{from: 2, apply: migrateV2Hints},
+}
+
+// FormatFromPath picks the pack format from a file name.
+func FormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".toml":
+		return FormatTOML
+	default:
+		return FormatJSON
+	}
+}
+
+// NormalizePack decodes a pack in the given format, migrates it to the
+// current SchemaVersion, and returns it as JSON.
+func NormalizePack(raw []byte, format string) ([]byte, error) {
+	doc := make(map[string]any)
+	var err error
+	switch format {
+	case FormatYAML:
+		err = yaml.Unmarshal(raw, &doc)
+	case FormatTOML:
+		err = toml.Unmarshal(raw, &doc)
+	case FormatJSON:
+		err = json.Unmarshal(raw, &doc)
+	default:
+		return nil, fmt.Errorf("unknown pack format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s pack: %w", format, err)
+	}
+
+	if err := migrate(doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+func migrate(doc map[string]any) error {
+	version := 1
+	if v, ok := doc["schema_version"]; ok {
+		n, ok := toInt(v)
+		if !ok || n < 1 {
+			return fmt.Errorf("invalid schema_version %v", v)
+		}
+		version = n
+	}
+	if version > SchemaVersion {
+		return fmt.Errorf("pack schema_version %d is newer than this build supports (%d)", version, SchemaVersion)
+	}
+
+	for _, m := range migrations {
+		if m.from < version {
+			continue
+		}
+		if err := m.apply(doc); err != nil {
+			return fmt.Errorf("migrating schema_version %d: %w", m.from, err)
+		}
+		version = m.from + 1
+	}
+	doc["schema_version"] = SchemaVersion
+	return nil
+}
+
+// migrateV1Answers folds the single "answer" of version 1 packs into the
+// front of the "answers" list.
+func migrateV1Answers(doc map[string]any) error {
+	for _, q := range questionDocs(doc) {
+		answer, ok := q["answer"]
+		if !ok {
+			continue
+		}
+		delete(q, "answer")
+		if s, isString := answer.(string); isString && s == "" {
+			continue
+		}
+		q["answers"] = append([]any{answer}, listOf(q["answers"])...)
+	}
+	return nil
+}
+
+// migrateV2Hints folds the single "hint" of version 2 packs into the front of
+// the "hints" list.
+func migrateV2Hints(doc map[string]any) error {
+	for _, q := range questionDocs(doc) {
+		hint, ok := q["hint"]
+		if !ok {
+			continue
+		}
+		delete(q, "hint")
+		if s, isString := hint.(string); isString && s == "" {
+			continue
+		}
+		q["hints"] = append([]any{hint}, listOf(q["hints"])...)
+	}
+	return nil
+}
+
+func questionDocs(doc map[string]any) []map[string]any {
+	// The TOML decoder returns arrays of tables as []map[string]any.
+	if tables, ok := doc["questions"].([]map[string]any); ok {
+		return tables
+	}
+	list, _ := doc["questions"].([]any)
+	out := make([]map[string]any, 0, len(list))
+	for _, item := range list {
+		if q, ok := item.(map[string]any); ok {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+// listOf returns the entries of a decoded list. Like questionDocs it accepts
+// the []map[string]any the TOML decoder returns for arrays of tables, such as
+// [[questions.answers]].
+func listOf(v any) []any {
+	if tables, ok := v.([]map[string]any); ok {
+		list := make([]any, len(tables))
+		for i, t := range tables {
+			list[i] = t
+		}
+		return list
+	}
+	list, _ := v.([]any)
+	return list
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		if n == float64(int(n)) {
+			return int(n), true
+		}
+	}
+	return 0, false
+}
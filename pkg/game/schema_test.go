@@ -0,0 +1,160 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+const yamlPack = `
+questions:
+  - id: 1
+    text: |
+      I speak without a mouth
+      and hear without ears.
+    answer: echo
+    hint: It's also a terminal command.
+  - id: 2
+    text: Which port?
+    answers:
+      - "22"
+      - text: ssh
+        match: exact
+final_message: EGG
+`
+
+const tomlPack = `
+schema_version = 2
+final_message = "EGG"
+
+[[questions]]
+id = 1
+text = """
+Multi-line
+riddle"""
+answers = ["echo"]
+hint = "It's also a terminal command."
+`
+
+func TestNormalizePackFormats(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		format string
+		raw    string
+	}{
+		{"yaml", FormatYAML, yamlPack},
+		{"toml", FormatTOML, tomlPack},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := NormalizePack([]byte(tt.raw), tt.format)
+			if err != nil {
+				t.Fatalf("NormalizePack: %v", err)
+			}
+			cfg, err := ParseConfig(raw)
+			if err != nil {
+				t.Fatalf("ParseConfig: %v", err)
+			}
+			if cfg.SchemaVersion != SchemaVersion {
+				t.Errorf("schema_version = %d, want %d", cfg.SchemaVersion, SchemaVersion)
+			}
+			q := cfg.Questions[0]
+			if !strings.Contains(q.Text, "\n") {
+				t.Errorf("expected multi-line text to survive, got %q", q.Text)
+			}
+			if _, ok := MatchAnswer("echo", q); !ok {
+				t.Errorf("expected echo to be accepted")
+			}
+		})
+	}
+}
+
+func TestMigrateV1FoldsAnswerIntoAnswers(t *testing.T) {
+	raw, err := NormalizePack([]byte(yamlPack), FormatYAML)
+	if err != nil {
+		t.Fatalf("NormalizePack: %v", err)
+	}
+	cfg, err := ParseConfig(raw)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+
+	q := cfg.Questions[0]
+	if q.Answer != "" || len(q.Answers) != 1 || q.Answers[0].Text != "echo" {
+		t.Fatalf("expected answer to move into answers, got answer=%q answers=%+v", q.Answer, q.Answers)
+	}
+	if a, ok := MatchAnswer("SSH", cfg.Questions[1]); !ok || a.Match != MatchExact {
+		t.Fatalf("expected object alias to keep its match policy, got %+v %v", a, ok)
+	}
+}
+
+func TestMigrateV2FoldsHintIntoHints(t *testing.T) {
+	raw, err := NormalizePack([]byte(tomlPack), FormatTOML)
+	if err != nil {
+		t.Fatalf("NormalizePack: %v", err)
+	}
+	cfg, err := ParseConfig(raw)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+
+	q := cfg.Questions[0]
+	if q.Hint != "" || len(q.Hints) != 1 || q.Hints[0].Text != "It's also a terminal command." {
+		t.Fatalf("expected hint to move into hints, got hint=%q hints=%+v", q.Hint, q.Hints)
+	}
+}
+
+func TestMigrateKeepsTOMLTables(t *testing.T) {
+	raw, err := NormalizePack([]byte(`
+final_message = "EGG"
+
+[[questions]]
+id = 1
+text = "Which port?"
+answer = "22"
+hint = "Secure shell."
+
+[[questions.answers]]
+text = "ssh"
+match = "exact"
+
+[[questions.hints]]
+text = "It's below 100."
+after_wrong = 2
+`), FormatTOML)
+	if err != nil {
+		t.Fatalf("NormalizePack: %v", err)
+	}
+	cfg, err := ParseConfig(raw)
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+
+	q := cfg.Questions[0]
+	if len(q.Answers) != 2 || q.Answers[0].Text != "22" || q.Answers[1].Text != "ssh" || q.Answers[1].Match != MatchExact {
+		t.Fatalf("expected answer and the answers table, got %+v", q.Answers)
+	}
+	if len(q.Hints) != 2 || q.Hints[0].Text != "Secure shell." || q.Hints[1].Text != "It's below 100." || q.Hints[1].AfterWrong != 2 {
+		t.Fatalf("expected hint and the hints table, got %+v", q.Hints)
+	}
+}
+
+func TestNormalizePackRejectsNewerSchema(t *testing.T) {
+	_, err := NormalizePack([]byte(`{"schema_version": 99, "questions": []}`), FormatJSON)
+	if err == nil || !strings.Contains(err.Error(), "newer") {
+		t.Fatalf("expected newer schema to be rejected, got %v", err)
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"hunt.yaml":      FormatYAML,
+		"hunt.YML":       FormatYAML,
+		"hunt.toml":      FormatTOML,
+		"hunt.json":      FormatJSON,
+		"hunt.pack":      FormatJSON,
+		"questions.json": FormatJSON,
+	} {
+		if got := FormatFromPath(path); got != want {
+			t.Errorf("FormatFromPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package game
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ScoreRules sets how many points a solved question is worth. A pack can
+// override them with a "scoring" block and per-question "points".
+type ScoreRules struct {
+	// Points is the base value of a question without its own Points.
+	Points int `json:"points"`
+	// TimeBonus is added for an instant solve and decays linearly to zero
+	// over TimeBonusSeconds.
+	TimeBonus        int `json:"time_bonus"`
+	TimeBonusSeconds int `json:"time_bonus_seconds"`
+	// HintPenalty is subtracted per revealed hint, WrongPenalty per wrong
+	// answer.
+	HintPenalty  int `json:"hint_penalty"`
+	WrongPenalty int `json:"wrong_penalty"`
+}
+
+// UnmarshalJSON takes the fields a scoring block leaves out from
+// DefaultScoreRules, so a pack that only sets "points" keeps the default
+// bonus and penalties. An explicit 0 turns one off.
+func (r *ScoreRules) UnmarshalJSON(b []byte) error {
+	type plain ScoreRules
+	rules := plain(DefaultScoreRules)
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return err
+	}
+	*r = ScoreRules(rules)
+	return nil
+}
+
+// DefaultScoreRules apply to packs without a scoring block.
+var DefaultScoreRules = ScoreRules{
+	Points:           100,
+	TimeBonus:        50,
+	TimeBonusSeconds: 300,
+	HintPenalty:      20,
+	WrongPenalty:     5,
+}
+
+// ScoreRules returns the pack's scoring rules, falling back to
+// DefaultScoreRules.
+func (c *Config) ScoreRules() ScoreRules {
+	if c.Scoring == nil {
+		return DefaultScoreRules
+	}
+	rules := *c.Scoring
+	if rules.Points == 0 {
+		rules.Points = DefaultScoreRules.Points
+	}
+	return rules
+}
+
+// BasePoints returns what q is worth before the time bonus and penalties:
+// its own points, or the pack default.
+func (r ScoreRules) BasePoints(q Question) int {
+	if q.Points != 0 {
+		return q.Points
+	}
+	return r.Points
+}
+
+// Score returns the points for solving q after wrong wrong answers, with
+// hints hints revealed, in solveTime. It is never negative.
+func (r ScoreRules) Score(q Question, wrong, hints int, solveTime time.Duration) int {
+	points := r.BasePoints(q)
+
+	if r.TimeBonusSeconds > 0 {
+		window := time.Duration(r.TimeBonusSeconds) * time.Second
+		if solveTime < window {
+			points += int(int64(r.TimeBonus) * int64(window-solveTime) / int64(window))
+		}
+	}
+	points -= hints*r.HintPenalty + wrong*r.WrongPenalty
+
+	if points < 0 {
+		return 0
+	}
+	return points
+}
+
+// QuestionResult records how one question was solved.
+type QuestionResult struct {
+	QuestionID int           `json:"question_id"`
+	Attempts   int           `json:"attempts"`
+	HintsUsed  int           `json:"hints_used"`
+	SolveTime  time.Duration `json:"solve_time"`
+	Points     int           `json:"points"`
+}
+
+// Penalty records points lost to a trap answer (see Response).
+type Penalty struct {
+	QuestionID int `json:"question_id"`
+	Points     int `json:"points"`
+}
+
+// Scorecard accumulates the results of a play-through.
+type Scorecard struct {
+	Rules     ScoreRules
+	Results   []QuestionResult
+	Penalties []Penalty
+}
+
+func NewScorecard(rules ScoreRules) Scorecard {
+	return Scorecard{Rules: rules}
+}
+
+// Record scores a solved question and appends its result.
+func (s *Scorecard) Record(q Question, wrong, hints int, solveTime time.Duration) QuestionResult {
+	result := QuestionResult{
+		QuestionID: q.ID,
+		Attempts:   wrong + 1,
+		HintsUsed:  hints,
+		SolveTime:  solveTime,
+		Points:     s.Rules.Score(q, wrong, hints, solveTime),
+	}
+	s.Results = append(s.Results, result)
+	return result
+}
+
+// Solved reports whether a result was recorded for the question with id.
+func (s Scorecard) Solved(id int) bool {
+	for _, r := range s.Results {
+		if r.QuestionID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Penalize takes points off the total for a trap answer to q.
+func (s *Scorecard) Penalize(q Question, points int) {
+	if points <= 0 {
+		return
+	}
+	s.Penalties = append(s.Penalties, Penalty{QuestionID: q.ID, Points: points})
+}
+
+// Total returns the sum of all recorded points minus penalties. It is never
+// negative.
+func (s Scorecard) Total() int {
+	total := 0
+	for _, r := range s.Results {
+		total += r.Points
+	}
+	for _, p := range s.Penalties {
+		total -= p.Points
+	}
+	if total < 0 {
+		return 0
+	}
+	return total
+}
+
+// Elapsed returns the summed solve time of all recorded questions.
+func (s Scorecard) Elapsed() time.Duration {
+	var total time.Duration
+	for _, r := range s.Results {
+		total += r.SolveTime
+	}
+	return total
+}
@@ -0,0 +1,90 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestScoreRules(t *testing.T) {
+	rules := ScoreRules{Points: 100, TimeBonus: 50, TimeBonusSeconds: 100, HintPenalty: 20, WrongPenalty: 5}
+	for _, tt := range []struct {
+		name  string
+		q     Question
+		wrong int
+		hints int
+		took  time.Duration
+		want  int
+	}{
+		{"instant", Question{}, 0, 0, 0, 150},
+		{"half the bonus window", Question{}, 0, 0, 50 * time.Second, 125},
+		{"after the bonus window", Question{}, 0, 0, 10 * time.Minute, 100},
+		{"penalties", Question{}, 2, 1, 10 * time.Minute, 70},
+		{"question points", Question{Points: 300}, 0, 0, 10 * time.Minute, 300},
+		{"never negative", Question{Points: 10}, 10, 3, 10 * time.Minute, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules.Score(tt.q, tt.wrong, tt.hints, tt.took); got != tt.want {
+				t.Errorf("Score = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigScoreRulesDefaults(t *testing.T) {
+	var c Config
+	if got := c.ScoreRules(); got != DefaultScoreRules {
+		t.Fatalf("expected defaults without a scoring block, got %+v", got)
+	}
+
+	c.Scoring = &ScoreRules{HintPenalty: 50}
+	got := c.ScoreRules()
+	if got.Points != DefaultScoreRules.Points || got.HintPenalty != 50 || got.TimeBonus != 0 {
+		t.Fatalf("unexpected rules %+v", got)
+	}
+}
+
+func TestPartialScoringBlockKeepsDefaults(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`{
+		"scoring": {"points": 250, "wrong_penalty": 0},
+		"questions": [{"id": 1, "text": "Q1", "answers": ["a1"]}],
+		"final_message": "EGG"
+	}`))
+	if err != nil {
+		t.Fatalf("ParseConfig: %v", err)
+	}
+	want := DefaultScoreRules
+	want.Points, want.WrongPenalty = 250, 0
+	if got := cfg.ScoreRules(); got != want {
+		t.Fatalf("ScoreRules = %+v, want %+v", got, want)
+	}
+
+	// Packing writes the merged block, so the explicit 0 survives.
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var packed Config
+	if err := json.Unmarshal(raw, &packed); err != nil {
+		t.Fatal(err)
+	}
+	if got := packed.ScoreRules(); got != want {
+		t.Fatalf("after a round trip ScoreRules = %+v, want %+v", got, want)
+	}
+}
+
+func TestScorecardRecord(t *testing.T) {
+	card := NewScorecard(ScoreRules{Points: 100, WrongPenalty: 10})
+	card.Record(Question{ID: 1}, 0, 0, time.Second)
+	r := card.Record(Question{ID: 2}, 2, 1, 2*time.Second)
+
+	if r.QuestionID != 2 || r.Attempts != 3 || r.HintsUsed != 1 || r.Points != 80 {
+		t.Fatalf("unexpected result %+v", r)
+	}
+	if card.Total() != 180 {
+		t.Errorf("Total = %d, want 180", card.Total())
+	}
+	if card.Elapsed() != 3*time.Second {
+		t.Errorf("Elapsed = %s, want 3s", card.Elapsed())
+	}
+}
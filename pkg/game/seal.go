@@ -0,0 +1,305 @@
+package game
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrLocked is returned by Config.Unlock when the input does not open the
+// next sealed question.
+var ErrLocked = errors.New("input does not unlock the next question")
+
+// unlockSaltSuffix separates unlock keys from answer hashes derived with the
+// same question salt; otherwise the stored hash would be the key.
+const unlockSaltSuffix = "/unlock"
+
+// sealedQuestion is the encrypted part of a chained question.
+type sealedQuestion struct {
+	Text      string     `json:"text"`
+	Hints     []Hint     `json:"hints"`
+	Level     string     `json:"level,omitempty"`
+	Responses []Response `json:"responses,omitempty"`
+	Choices   []string   `json:"choices,omitempty"`
+}
+
+// sealedFinale is the encrypted part of a chained finale.
+type sealedFinale struct {
+	Message      string `json:"message"`
+	Hint         string `json:"hint"`
+	FlagTemplate string `json:"flag_template,omitempty"`
+	FlagSecret   string `json:"flag_secret,omitempty"`
+}
+
+// Locked reports whether the question text is still encrypted.
+func (q Question) Locked() bool {
+	return q.Sealed != ""
+}
+
+// FinaleLocked reports whether the final message is still encrypted.
+func (c *Config) FinaleLocked() bool {
+	return c.SealedFinal != ""
+}
+
+// SealChain encrypts every question after the first, and the finale, with a
+// random content key. That key is wrapped under keys derived from each
+// accepted answer of the previous question, so the text only becomes readable
+// once that question is solved (see Unlock).
+//
+// It must run before HashAnswers, while the answers are still plaintext. A
+// question whose answers cannot be hashed breaks the chain: the following
+// question is left readable and a warning is returned.
+func (c *Config) SealChain() (warnings []string, err error) {
+	for i := range c.Questions {
+		q := &c.Questions[i]
+
+		salt, err := q.salt()
+		if err != nil {
+			return nil, err
+		}
+		forms, ok := chainForms(*q)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("question %d: answers cannot be hashed, next question is not encrypted", q.ID))
+			continue
+		}
+
+		contentKey := make([]byte, 32)
+		if _, err := rand.Read(contentKey); err != nil {
+			return nil, err
+		}
+
+		var slots []string
+		for _, form := range forms {
+			key, err := unlockKey(form, salt)
+			if err != nil {
+				return nil, fmt.Errorf("question %d: %w", q.ID, err)
+			}
+			slot, err := sealBytes(key, contentKey)
+			if err != nil {
+				return nil, fmt.Errorf("question %d: %w", q.ID, err)
+			}
+			slots = append(slots, slot)
+		}
+		q.Unlocks = slots
+		if q.TimeLimit > 0 && c.ExpireAction(*q) == ExpireSkip && i+1 < len(c.Questions) {
+			warnings = append(warnings, fmt.Sprintf("question %d: skipping it on timeout leaves question %d encrypted", q.ID, c.Questions[i+1].ID))
+		}
+
+		if i+1 < len(c.Questions) {
+			next := &c.Questions[i+1]
+			if next.Sealed, err = sealJSON(contentKey, sealedQuestion{Text: next.Text, Hints: next.HintLadder(), Level: next.Level, Responses: next.Responses, Choices: next.Choices}); err != nil {
+				return nil, fmt.Errorf("question %d: %w", next.ID, err)
+			}
+			if next.Level != "" {
+				// The level code opens the question too, without the answer.
+				if next.LevelKey, err = sealLevelKey(next, contentKey); err != nil {
+					return nil, fmt.Errorf("question %d: %w", next.ID, err)
+				}
+			}
+			next.Text, next.Hint, next.Hints, next.Level, next.Responses, next.Choices = "", "", nil, "", nil, nil
+			continue
+		}
+
+		finale := sealedFinale{Message: c.FinalMessage, Hint: c.FinalHint, FlagTemplate: c.FlagTemplate, FlagSecret: c.FlagSecret}
+		if c.SealedFinal, err = sealJSON(contentKey, finale); err != nil {
+			return nil, fmt.Errorf("finale: %w", err)
+		}
+		c.FinalMessage, c.FinalHint, c.FlagTemplate, c.FlagSecret = "", "", "", ""
+	}
+	return warnings, nil
+}
+
+// chainForms collects the normalized forms of every accepted answer. ok is
+// false if any answer uses a checker that cannot be reproduced from a hash.
+func chainForms(q Question) (forms []string, ok bool) {
+	seen := make(map[string]bool)
+	for _, a := range q.AcceptedAnswers() {
+		checker, err := checkerFor(q, a)
+		if err != nil || a.Hashed() {
+			return nil, false
+		}
+		hashable, isHashable := checker.(HashableChecker)
+		if !isHashable {
+			return nil, false
+		}
+		for _, form := range hashable.Forms(a.Text) {
+			if !seen[form] {
+				seen[form] = true
+				forms = append(forms, form)
+			}
+		}
+	}
+	return forms, len(forms) > 0
+}
+
+// Unlock decrypts the question after index (or the finale after the last
+// question) using the player's accepted input for question index. It is a
+// no-op when the next question is not sealed.
+func (c *Config) Unlock(index int, input string) error {
+	if index < 0 || index >= len(c.Questions) {
+		return fmt.Errorf("question index %d out of range", index)
+	}
+	q := c.Questions[index]
+	if len(q.Unlocks) == 0 {
+		return nil
+	}
+	salt, err := hex.DecodeString(q.Salt)
+	if err != nil {
+		return fmt.Errorf("question %d: invalid salt: %w", q.ID, err)
+	}
+
+	tried := make(map[string]bool)
+	for _, a := range q.AcceptedAnswers() {
+		checker, err := checkerFor(q, a)
+		if err != nil {
+			continue
+		}
+		hashable, ok := checker.(HashableChecker)
+		if !ok {
+			continue
+		}
+		for _, form := range hashable.Forms(input) {
+			if form == "" || tried[form] {
+				continue
+			}
+			tried[form] = true
+
+			key, err := unlockKey(form, salt)
+			if err != nil {
+				return err
+			}
+			for _, slot := range q.Unlocks {
+				contentKey, err := openBytes(key, slot)
+				if err != nil {
+					continue
+				}
+				return c.openNext(index, contentKey)
+			}
+		}
+	}
+	return ErrLocked
+}
+
+func (c *Config) openNext(index int, contentKey []byte) error {
+	if index+1 < len(c.Questions) {
+		return c.openQuestion(index+1, contentKey)
+	}
+
+	if !c.FinaleLocked() {
+		return nil
+	}
+	var content sealedFinale
+	if err := openJSON(contentKey, c.SealedFinal, &content); err != nil {
+		return fmt.Errorf("finale: %w", err)
+	}
+	c.FinalMessage, c.FinalHint, c.SealedFinal = content.Message, content.Hint, ""
+	c.FlagTemplate, c.FlagSecret = content.FlagTemplate, content.FlagSecret
+	return nil
+}
+
+// openQuestion decrypts question index with its content key.
+func (c *Config) openQuestion(index int, contentKey []byte) error {
+	q := &c.Questions[index]
+	if !q.Locked() {
+		return nil
+	}
+	var content sealedQuestion
+	if err := openJSON(contentKey, q.Sealed, &content); err != nil {
+		return fmt.Errorf("question %d: %w", q.ID, err)
+	}
+	q.Text, q.Hints, q.Level, q.Sealed = content.Text, content.Hints, content.Level, ""
+	q.Responses = content.Responses
+	q.Choices = content.Choices
+	return nil
+}
+
+func sealLevelKey(q *Question, contentKey []byte) (string, error) {
+	salt, err := q.salt()
+	if err != nil {
+		return "", err
+	}
+	key, err := levelKey(q.Level, salt)
+	if err != nil {
+		return "", err
+	}
+	return sealBytes(key, contentKey)
+}
+
+// salt returns the question salt, generating one if the pack has none yet.
+func (q *Question) salt() ([]byte, error) {
+	if q.Salt == "" {
+		salt := make([]byte, saltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("question %d: generating salt: %w", q.ID, err)
+		}
+		q.Salt = hex.EncodeToString(salt)
+	}
+	salt, err := hex.DecodeString(q.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("question %d: invalid salt: %w", q.ID, err)
+	}
+	return salt, nil
+}
+
+func unlockKey(form string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(form), append(append([]byte{}, salt...), unlockSaltSuffix...), hashN, hashR, hashP, 32)
+}
+
+func sealJSON(key []byte, v any) (string, error) {
+	plain, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return sealBytes(key, plain)
+}
+
+func openJSON(key []byte, sealed string, v any) error {
+	plain, err := openBytes(key, sealed)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(plain, v)
+}
+
+// sealBytes encrypts plain with AES-256-GCM and returns base64(nonce || ciphertext).
+func sealBytes(key, plain []byte) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, plain, nil)), nil
+}
+
+func openBytes(key []byte, sealed string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) < gcm.NonceSize() {
+		return nil, errors.New("sealed data too short")
+	}
+	return gcm.Open(nil, raw[:gcm.NonceSize()], raw[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
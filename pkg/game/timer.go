@@ -0,0 +1,84 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// What happens when a question's time_limit runs out.
+const (
+	ExpireRevealHint = "reveal_hint"
+	ExpireSkip       = "skip"
+	ExpireEnd        = "end"
+)
+
+// DefaultTimeUpMessage is shown when the game ends on a timer and the pack
+// sets no time_up_message.
+const DefaultTimeUpMessage = "TIME'S UP. The egg stays hidden this time."
+
+// ExpireAction returns what to do when q runs out of time: the question's
+// on_expire, else the pack's, else ExpireRevealHint.
+func (c *Config) ExpireAction(q Question) string {
+	switch {
+	case q.OnExpire != "":
+		return q.OnExpire
+	case c.OnExpire != "":
+		return c.OnExpire
+	default:
+		return ExpireRevealHint
+	}
+}
+
+// QuestionLimit returns the question's time limit, or 0 if it has none.
+func (q Question) QuestionLimit() time.Duration {
+	return time.Duration(q.TimeLimit) * time.Second
+}
+
+// GameDeadline returns when the whole game ends for a player who started at
+// start: the earlier of start+time_limit and the pack's deadline. ok is false
+// when the pack sets neither.
+func (c *Config) GameDeadline(start time.Time) (deadline time.Time, ok bool) {
+	if c.TimeLimit > 0 {
+		deadline, ok = start.Add(time.Duration(c.TimeLimit)*time.Second), true
+	}
+	if c.Deadline != "" {
+		at, err := time.Parse(time.RFC3339, c.Deadline)
+		if err == nil && (!ok || at.Before(deadline)) {
+			deadline, ok = at, true
+		}
+	}
+	return deadline, ok
+}
+
+// TimeUpText returns the finale shown when time runs out.
+func (c *Config) TimeUpText() string {
+	if c.TimeUpMessage != "" {
+		return c.TimeUpMessage
+	}
+	return DefaultTimeUpMessage
+}
+
+func validateTimers(c *Config) error {
+	if c.Deadline != "" {
+		if _, err := time.Parse(time.RFC3339, c.Deadline); err != nil {
+			return fmt.Errorf("invalid deadline %q: want RFC 3339, e.g. 2026-10-31T18:00:00Z", c.Deadline)
+		}
+	}
+	if err := validateExpireAction(c.OnExpire); err != nil {
+		return err
+	}
+	for _, q := range c.Questions {
+		if err := validateExpireAction(q.OnExpire); err != nil {
+			return fmt.Errorf("question %d: %w", q.ID, err)
+		}
+	}
+	return nil
+}
+
+func validateExpireAction(action string) error {
+	switch action {
+	case "", ExpireRevealHint, ExpireSkip, ExpireEnd:
+		return nil
+	}
+	return fmt.Errorf("unknown on_expire %q", action)
+}
@@ -0,0 +1,58 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExpireAction(t *testing.T) {
+	c := Config{OnExpire: ExpireSkip}
+	if got := c.ExpireAction(Question{}); got != ExpireSkip {
+		t.Errorf("pack default: got %q", got)
+	}
+	if got := c.ExpireAction(Question{OnExpire: ExpireEnd}); got != ExpireEnd {
+		t.Errorf("question override: got %q", got)
+	}
+	if got := (&Config{}).ExpireAction(Question{}); got != ExpireRevealHint {
+		t.Errorf("default: got %q", got)
+	}
+}
+
+func TestGameDeadline(t *testing.T) {
+	start := time.Date(2026, 10, 31, 17, 0, 0, 0, time.UTC)
+
+	if _, ok := (&Config{}).GameDeadline(start); ok {
+		t.Fatalf("expected no deadline without time_limit or deadline")
+	}
+
+	c := Config{TimeLimit: 1800}
+	if got, _ := c.GameDeadline(start); !got.Equal(start.Add(30 * time.Minute)) {
+		t.Errorf("time_limit: got %s", got)
+	}
+
+	c.Deadline = "2026-10-31T17:10:00Z"
+	if got, _ := c.GameDeadline(start); !got.Equal(start.Add(10 * time.Minute)) {
+		t.Errorf("expected the earlier wall-clock deadline, got %s", got)
+	}
+}
+
+func TestValidateTimers(t *testing.T) {
+	q := Question{ID: 1, Answer: "a"}
+	for _, tt := range []struct {
+		name string
+		c    Config
+		want string
+	}{
+		{"bad deadline", Config{Questions: []Question{q}, Deadline: "tomorrow"}, "invalid deadline"},
+		{"bad pack action", Config{Questions: []Question{q}, OnExpire: "explode"}, "unknown on_expire"},
+		{"bad question action", Config{Questions: []Question{{ID: 1, Answer: "a", OnExpire: "explode"}}}, "question 1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.c.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -1,18 +1,259 @@
 package game
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Built-in match policies for a single accepted answer. Any registered
+// AnswerChecker name may be used as a policy.
+const (
+	// MatchFuzzy tolerates case, separators, and small typos (the default).
+	MatchFuzzy = "fuzzy"
+	// MatchExact requires the normalized input to equal the answer.
+	MatchExact = "exact"
+)
+
+// Answer is one accepted solution for a question. In packs it may be written
+// either as a plain string or as an object with a per-alias match policy
+// (the name of an AnswerChecker):
+//
+//	"answers": ["secure shell", {"text": "openssh", "match": "exact"}]
+//
+// Packed answers may carry Hashes (see Question.HashAnswers) instead of Text.
+// In question graphs, Opens lists the ids of questions this answer branches
+// to.
+type Answer struct {
+	Text   string   `json:"text,omitempty"`
+	Match  string   `json:"match,omitempty"`
+	Hashes []string `json:"hashes,omitempty"`
+	Opens  []int    `json:"opens,omitempty"`
+}
+
+// Hashed reports whether the answer is stored as hashes only.
+func (a Answer) Hashed() bool {
+	return a.Text == "" && len(a.Hashes) > 0
+}
+
+// Label identifies the answer in logs and debug output without needing the
+// plaintext.
+func (a Answer) Label() string {
+	if !a.Hashed() {
+		return a.Text
+	}
+	h := a.Hashes[0]
+	if len(h) > 8 {
+		h = h[:8]
+	}
+	return "hashed:" + h
+}
+
+func (a *Answer) UnmarshalJSON(b []byte) error {
+	var text string
+	if err := json.Unmarshal(b, &text); err == nil {
+		*a = Answer{Text: text}
+		return nil
+	}
+
+	type plain Answer
+	var obj plain
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return fmt.Errorf("answer must be a string or an object: %w", err)
+	}
+	*a = Answer(obj)
+	return nil
+}
 
 type Question struct {
-	ID     int    `json:"id"`
-	Text   string `json:"text"`
-	Answer string `json:"answer"`
-	Hint   string `json:"hint"`
+	ID      int      `json:"id"`
+	Title   string   `json:"title,omitempty"`
+	Text    string   `json:"text"`
+	Answer  string   `json:"answer,omitempty"`
+	Answers []Answer `json:"answers,omitempty"`
+	Hint    string   `json:"hint,omitempty"`
+	Hints   []Hint   `json:"hints,omitempty"`
+
+	// Parts replace Answer and Answers for questions that need several
+	// answers at once (see Part).
+	Parts []Part `json:"parts,omitempty"`
+
+	// Choices make this a multiple-choice question. Its answers are choice
+	// numbers from 1, e.g. "2" for the second choice.
+	Choices []string `json:"choices,omitempty"`
+
+	// Normalize adds normalization stages to the exact, fuzzy and set
+	// checks of this question (see Normalizer). It defaults to the pack's.
+	Normalize []string `json:"normalize,omitempty"`
+
+	// Responses reply to specific wrong answers (see Response).
+	Responses []Response `json:"responses,omitempty"`
+
+	// Check names the AnswerChecker used for answers without their own match
+	// policy. Empty means fuzzy.
+	Check string `json:"check,omitempty"`
+
+	// Points overrides the pack's base points for this question.
+	Points int `json:"points,omitempty"`
+
+	// Category places the question on the category board (see
+	// Config.IsBoard), in a column with the other questions of the category.
+	Category string `json:"category,omitempty"`
+
+	// Pool names the Config.Pools entry the question may be drawn from.
+	Pool string `json:"pool,omitempty"`
+
+	// Question graph (see Config.IsGraph). The question becomes available
+	// once every id in Requires and at least one in RequiresAny is done, and,
+	// if an answer elsewhere opens it, once that answer was given. Optional
+	// questions are side puzzles the game can end without.
+	Requires    []int `json:"requires,omitempty"`
+	RequiresAny []int `json:"requires_any,omitempty"`
+	Optional    bool  `json:"optional,omitempty"`
+
+	// TimeLimit is the number of seconds the player has for this question;
+	// OnExpire overrides the pack's expiry action for it.
+	TimeLimit int    `json:"time_limit,omitempty"`
+	OnExpire  string `json:"on_expire,omitempty"`
+
+	// Salt is the hex encoded scrypt salt for hashed answers.
+	Salt string `json:"salt,omitempty"`
+
+	// Sealed holds the encrypted text and hints of a chained question until
+	// the previous question is solved; Unlocks holds the wrapped key for the
+	// next question, one slot per accepted answer form (see Config.SealChain).
+	Sealed  string   `json:"sealed,omitempty"`
+	Unlocks []string `json:"unlocks,omitempty"`
+
+	// Level is the code that jumps straight to this question. In chained
+	// packs it is sealed with the text, and LevelKey holds the question's key
+	// wrapped under the code instead (see Config.JumpToLevel).
+	Level    string `json:"level,omitempty"`
+	LevelKey string `json:"level_key,omitempty"`
+}
+
+// AcceptedAnswers returns every answer the question accepts: the legacy
+// single Answer (if set) followed by the Answers list.
+func (q Question) AcceptedAnswers() []Answer {
+	out := make([]Answer, 0, len(q.Answers)+1)
+	if q.Answer != "" {
+		out = append(out, Answer{Text: q.Answer})
+	}
+	for _, a := range q.Answers {
+		if a.Text != "" || a.Hashed() {
+			out = append(out, a)
+		}
+	}
+	return out
 }
 
 type Config struct {
-	Questions    []Question `json:"questions"`
-	FinalMessage string     `json:"final_message"`
-	FinalHint    string     `json:"final_hint"`
+	SchemaVersion int        `json:"schema_version,omitempty"`
+	Questions     []Question `json:"questions"`
+	FinalMessage  string     `json:"final_message"`
+	FinalHint     string     `json:"final_hint"`
+
+	// Scoring overrides DefaultScoreRules (see Config.ScoreRules).
+	Scoring *ScoreRules `json:"scoring,omitempty"`
+
+	// Lockout limits guessing (see Config.LockoutRules).
+	Lockout *LockoutRules `json:"lockout,omitempty"`
+
+	// TimeLimit (seconds from the first question) and Deadline (RFC 3339)
+	// end the whole game; OnExpire is the default action for questions with
+	// a time_limit. TimeUpMessage replaces the finale when time runs out.
+	TimeLimit     int    `json:"time_limit,omitempty"`
+	Deadline      string `json:"deadline,omitempty"`
+	OnExpire      string `json:"on_expire,omitempty"`
+	TimeUpMessage string `json:"time_up_message,omitempty"`
+
+	// LevelCodes shows a level code on every question after the first that
+	// can be entered on the intro screen to continue from there. Codes are
+	// derived from LevelSecret, which the packer strips.
+	LevelCodes  bool   `json:"level_codes,omitempty"`
+	LevelSecret string `json:"level_secret,omitempty"`
+
+	// PlayerPrompt asks for the player's name or team after the boot intro
+	// when set; it is the prompt's title.
+	PlayerPrompt string `json:"player_prompt,omitempty"`
+
+	// FlagTemplate renders a flag of their own for every player at the
+	// finale (see RenderFlag), keyed with FlagSecret. Chained packs seal both
+	// with the final message.
+	FlagTemplate string `json:"flag_template,omitempty"`
+	FlagSecret   string `json:"flag_secret,omitempty"`
+
+	// Pools and Shuffle give every player their own draw of questions (see
+	// Config.Sample).
+	Pools   []Pool `json:"pools,omitempty"`
+	Shuffle bool   `json:"shuffle,omitempty"`
+
+	// Normalize is the default normalization stages of every question.
+	Normalize []string `json:"normalize,omitempty"`
+
+	// HideNearMiss stops telling players that a wrong answer was one typo
+	// away (see FindNearMiss), for puzzles where that gives too much away.
+	HideNearMiss bool `json:"hide_near_miss,omitempty"`
+
+	// SealedFinal holds the encrypted final message and hint of a chained
+	// pack until the last question is solved.
+	SealedFinal string `json:"sealed_final,omitempty"`
+}
+
+// Validate reports pack errors that would make questions unanswerable, or
+// timers, the question graph, pools, flags, level codes or the lockout
+// unusable.
+func (c *Config) Validate() error {
+	if _, err := ParseNormalizer(c.Normalize); err != nil {
+		return err
+	}
+	if err := validateTimers(c); err != nil {
+		return err
+	}
+	if err := validateGraph(c); err != nil {
+		return err
+	}
+	if err := validateBoard(c); err != nil {
+		return err
+	}
+	if err := validatePools(c); err != nil {
+		return err
+	}
+	if err := validateFlag(c); err != nil {
+		return err
+	}
+	if err := validateLockout(c); err != nil {
+		return err
+	}
+	if err := validateLevels(c); err != nil {
+		return err
+	}
+	for _, q := range c.Questions {
+		if len(q.Parts) > 0 {
+			if err := validateParts(q); err != nil {
+				return err
+			}
+			continue
+		}
+		answers := q.AcceptedAnswers()
+		if len(answers) == 0 {
+			return fmt.Errorf("question %d: no accepted answers", q.ID)
+		}
+		for _, a := range answers {
+			if err := validateAnswer(q, a); err != nil {
+				return fmt.Errorf("question %d: %w", q.ID, err)
+			}
+		}
+		if err := validateResponses(q); err != nil {
+			return err
+		}
+		if len(q.Choices) > 0 {
+			if err := validateChoices(q); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 type TickMsg time.Time
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"ctf-tool/pkg/ui/theme"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openBoard shows the category board. The cursor stays where the player
+// left it, so they can work down a column.
+func (m *Model) openBoard() {
+	m.State = StateBoard
+	m.clampBoardCursor()
+}
+
+// boardColumns returns the question indexes of every category, cheapest
+// first.
+func (m *Model) boardColumns() [][]int {
+	var columns [][]int
+	for _, category := range m.Config.Categories() {
+		columns = append(columns, m.Config.CategoryQuestions(category))
+	}
+	return columns
+}
+
+func (m *Model) clampBoardCursor() {
+	columns := m.boardColumns()
+	if len(columns) == 0 {
+		m.BoardCol, m.BoardRow = 0, 0
+		return
+	}
+	m.BoardCol = clampIndex(m.BoardCol, len(columns))
+	m.BoardRow = clampIndex(m.BoardRow, len(columns[m.BoardCol]))
+}
+
+func clampIndex(i, n int) int {
+	if i >= n {
+		i = n - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return i
+}
+
+// updateBoard handles the category board.
+func (m *Model) updateBoard(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case game.TickMsg:
+		if m.deadlinePassed(time.Time(msg)) {
+			m.TimedOut = true
+			return m.startFinale()
+		}
+		// No theme is active here to keep the tick loop alive.
+		return tick()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "left", "h":
+			m.BoardCol--
+		case "right", "l":
+			m.BoardCol++
+		case "up", "k":
+			m.BoardRow--
+		case "down", "j":
+			m.BoardRow++
+		case "enter":
+			return m.openBoardCell()
+		}
+		m.clampBoardCursor()
+	}
+	return nil
+}
+
+// openBoardCell opens the highlighted question unless it is solved or still
+// waiting for prerequisites.
+func (m *Model) openBoardCell() tea.Cmd {
+	columns := m.boardColumns()
+	if m.BoardCol >= len(columns) || m.BoardRow >= len(columns[m.BoardCol]) {
+		return nil
+	}
+	index := columns[m.BoardCol][m.BoardRow]
+	for _, available := range m.Config.Available(m.Trail) {
+		if available == index {
+			return m.selectQuestion(index)
+		}
+	}
+	return nil
+}
+
+func (m *Model) boardView() string {
+	available := make(map[int]bool)
+	for _, i := range m.Config.Available(m.Trail) {
+		available[i] = true
+	}
+	rules := m.Config.ScoreRules()
+
+	board := theme.Board{Col: m.BoardCol, Row: m.BoardRow, Status: m.statusLine()}
+	for c, column := range m.boardColumns() {
+		category := theme.BoardCategory{Name: m.Config.Categories()[c]}
+		for _, index := range column {
+			q := m.Config.Questions[index]
+			category.Cells = append(category.Cells, theme.BoardCell{
+				Points: rules.BasePoints(q),
+				Solved: m.Trail.IsDone(q.ID),
+				Closed: !m.Trail.IsDone(q.ID) && !available[index],
+			})
+		}
+		board.Categories = append(board.Categories, category)
+	}
+
+	style := theme.DefaultBoardStyle
+	if styler, ok := m.ActiveTheme.(theme.BoardStyler); ok {
+		style = styler.BoardStyle()
+	}
+	return theme.RenderBoard(m.Width, m.Height, board, style)
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCategoryBoard(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Category: "Web", Text: "W1", Answer: "A1", Points: 100},
+			{ID: 2, Category: "Web", Text: "W2", Answer: "A2", Points: 200},
+			{ID: 3, Category: "Crypto", Text: "C1", Answer: "A3", Points: 100},
+		},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.ActiveBoot = nil
+	m.Width, m.Height = 80, 24
+
+	send := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	answer := func(input string) {
+		m.State = StateQuestion
+		m.Input.SetValue(input)
+		send(tea.KeyMsg{Type: tea.KeyEnter})
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateBoard {
+		t.Fatalf("expected the board, state=%s", gameStateName(m.State))
+	}
+	if view := m.View(); !strings.Contains(view, "CRYPTO") || !strings.Contains(view, "200") {
+		t.Fatalf("board should list categories and points:\n%s", view)
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.CurrentQuestionIndex != 1 {
+		t.Fatalf("expected the 200 point web question, state=%s index=%d", gameStateName(m.State), m.CurrentQuestionIndex)
+	}
+
+	answer("A2")
+	if m.State != StateBoard {
+		t.Fatalf("expected to return to the board, state=%s", gameStateName(m.State))
+	}
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateBoard {
+		t.Fatalf("a solved cell must not open again")
+	}
+
+	for _, step := range []struct {
+		key   tea.KeyType
+		input string
+	}{{tea.KeyUp, "A1"}, {tea.KeyRight, "A3"}} {
+		send(tea.KeyMsg{Type: step.key})
+		send(tea.KeyMsg{Type: tea.KeyEnter})
+		answer(step.input)
+	}
+	if m.State != StateSuccess {
+		t.Fatalf("expected the finale once the board is cleared, state=%s", gameStateName(m.State))
+	}
+}
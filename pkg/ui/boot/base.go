@@ -8,7 +8,12 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-type BaseIntro struct{}
+// BaseIntro also remembers the player's name for intros that greet them.
+type BaseIntro struct {
+	player string
+}
+
+func (b *BaseIntro) SetPlayer(name string) { b.player = name }
 
 // Safe default for intro compatibility.
 func (b BaseIntro) IsCompatible(c caps.Capabilities) bool {
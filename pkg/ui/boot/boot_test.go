@@ -1,6 +1,7 @@
 package boot
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -94,3 +95,15 @@ func TestBootProfileCompatibility(t *testing.T) {
 		t.Fatalf("prism profile should support truecolor terminal")
 	}
 }
+
+func TestBootProfilesGreetPlayer(t *testing.T) {
+	for _, intro := range []Intro{NewNeonCipherIntro(), NewAmberGridIntro()} {
+		intro.(PlayerAware).SetPlayer("Blue Team")
+		for !intro.Done() {
+			intro.Update(game.TickMsg(time.Now()))
+		}
+		if view := intro.View(72, 20); !strings.Contains(view, "BLUE TEAM") {
+			t.Errorf("%s should greet the player:\n%s", intro.Name(), view)
+		}
+	}
+}
@@ -21,6 +21,12 @@ type CapabilityAware interface {
 	IsCompatible(c caps.Capabilities) bool
 }
 
+// PlayerAware is optional and lets intros greet a player whose name is known
+// before the game starts (given on the command line or by the web page).
+type PlayerAware interface {
+	SetPlayer(name string)
+}
+
 type Constructor func() Intro
 
 var Registry = []Constructor{}
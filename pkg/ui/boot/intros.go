@@ -60,6 +60,9 @@ func (i *NeonCipherIntro) View(width, height int) string {
 		"ALIGNING TERMINAL CHROMA... LOCKED",
 		"PROMPT CHANNEL: READY",
 	}
+	if i.player != "" {
+		script = append(script, "OPERATOR "+strings.ToUpper(i.player)+": AUTHENTICATED")
+	}
 
 	logStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#A7FFF2"))
 	cursorStyle := lipgloss.NewStyle().Foreground(accent).Bold(true)
@@ -171,6 +174,9 @@ func (i *AmberGridIntro) View(width, height int) string {
 		"CHROMA CALIBRATION....... OK",
 		"PROMPT RELAY............. READY",
 	}
+	if i.player != "" {
+		checks = append(checks, "OPERATOR................. "+strings.ToUpper(i.player))
+	}
 
 	visible := minInt(len(checks), i.frame/18+1)
 	var out []string
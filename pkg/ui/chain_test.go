@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestChainedPackUnlocksOnCorrectAnswer(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "A1"},
+			{ID: 2, Text: "Q2", Answer: "A2"},
+		},
+		FinalMessage: "EGG",
+	}
+	if _, err := cfg.SealChain(); err != nil {
+		t.Fatalf("SealChain: %v", err)
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	if got := m.currentQuestion(); got.Text != "Q1" {
+		t.Fatalf("expected first question to be readable, got %q", got.Text)
+	}
+
+	m.Input.SetValue("A1")
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+
+	if m.CurrentQuestionIndex != 1 {
+		t.Fatalf("expected to advance to question 2, got index %d", m.CurrentQuestionIndex)
+	}
+	if got := m.currentQuestion(); got.Text != "Q2" {
+		t.Fatalf("expected question 2 to be decrypted, got %q (chain error %q)", got.Text, m.ChainError)
+	}
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"ctf-tool/pkg/ui/theme"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// wrongChoiceFeedback is shown for a wrong choice the pack has no reply to,
+// since nothing else on screen changes.
+const wrongChoiceFeedback = "WRONG CHOICE"
+
+// updateChoices handles the keys and mouse of multiple-choice question q:
+// arrows or the wheel move the selection, Enter answers with it, and a
+// number key or a click answers with that choice right away.
+func (m *Model) updateChoices(q game.Question, msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "left", "k":
+			m.moveChoice(q, -1)
+		case "down", "right", "j":
+			m.moveChoice(q, 1)
+		case "enter":
+			return m.pickChoice(q, m.ChoiceIndex)
+		default:
+			var n int
+			if _, err := fmt.Sscanf(msg.String(), "%d", &n); err == nil && n >= 1 && n <= len(q.Choices) {
+				return m.pickChoice(q, n-1)
+			}
+		}
+	case tea.MouseMsg:
+		switch {
+		case msg.Button == tea.MouseButtonWheelUp:
+			m.moveChoice(q, -1)
+		case msg.Button == tea.MouseButtonWheelDown:
+			m.moveChoice(q, 1)
+		case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+			if i, ok := m.choiceAt(q, msg.Y); ok {
+				return m.pickChoice(q, i)
+			}
+		}
+	}
+	return nil
+}
+
+// syncMouse turns mouse reporting on while a multiple-choice question is
+// open, so its choices can be clicked, and off again after it, since it
+// stops the terminal's own text selection. A chained pack only shows the
+// choices once the question is unlocked, so this can't be decided up front.
+func (m *Model) syncMouse() tea.Cmd {
+	want := m.State == StateQuestion && len(m.Config.Questions) > 0 &&
+		len(m.Config.Questions[m.CurrentQuestionIndex].Choices) > 0
+	if want == m.Mouse {
+		return nil
+	}
+	m.Mouse = want
+	if want {
+		return tea.EnableMouseCellMotion
+	}
+	return tea.DisableMouse
+}
+
+func (m *Model) moveChoice(q game.Question, step int) {
+	m.ChoiceIndex = min(max(m.ChoiceIndex+step, 0), len(q.Choices)-1)
+}
+
+// pickChoice answers q with choice i. It does nothing during a lockout.
+func (m *Model) pickChoice(q game.Question, i int) tea.Cmd {
+	if m.lockedOut(time.Now()) {
+		return nil
+	}
+	m.ChoiceIndex = i
+	guess := game.NewGuess(game.ChoiceInput(i), q)
+	if match, ok := guess.Answer(); ok {
+		return m.solve(q, match, guess.Input)
+	}
+	m.WrongAnswers++
+	m.respond(q, guess)
+	if m.Feedback == "" {
+		m.Feedback = wrongChoiceFeedback
+	}
+	m.strike(time.Now())
+	m.updateHints(time.Now(), false)
+	return nil
+}
+
+// choiceAt finds the choice drawn on screen row y. The theme's view starts at
+// the top of the screen, so the rows it reports are screen rows.
+func (m *Model) choiceAt(q game.Question, y int) (int, bool) {
+	locator, ok := m.ActiveTheme.(theme.InputLocator)
+	if !ok {
+		return 0, false
+	}
+	i, ok := locator.InputAt(y)
+	return i, ok && i < len(q.Choices)
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"ctf-tool/pkg/ui/theme"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMultipleChoiceKeysAndMouse(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "2", Choices: []string{"telnet", "ssh", "http"}},
+			{ID: 2, Text: "Is it 1) aes?", Answer: "3", Choices: []string{"aes", "rsa", "sha256"}},
+		},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+	m.Width, m.Height = 80, 24
+
+	update := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	update(tea.KeyMsg{Type: tea.KeyDown})
+	update(tea.KeyMsg{Type: tea.KeyDown})
+	update(tea.KeyMsg{Type: tea.KeyDown})
+	if m.ChoiceIndex != 2 {
+		t.Fatalf("arrows should move to the last choice and stop, got %d", m.ChoiceIndex)
+	}
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.WrongAnswers != 1 || m.Feedback != wrongChoiceFeedback {
+		t.Fatalf("expected a wrong choice, state=%s wrong=%d feedback=%q", gameStateName(m.State), m.WrongAnswers, m.Feedback)
+	}
+	if m.Input.Value() != "" {
+		t.Fatalf("keys must not reach the text input, got %q", m.Input.Value())
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if m.State == StateQuestion || !m.Trail.IsDone(1) {
+		t.Fatalf("number key should answer with that choice, state=%s", gameStateName(m.State))
+	}
+
+	// Clicks go by the rows the theme drew its choices on, so the question
+	// text quoting a choice is not one.
+	m.CurrentQuestionIndex = 1
+	m.resetQuestionState()
+	m.State = StateQuestion
+	m.TypewriterIndex = len(cfg.Questions[1].Text)
+	m.ActiveTheme = theme.NewBlueprintTheme()
+	questionY, y := -1, -1
+	for i, line := range strings.Split(m.View(), "\n") {
+		switch {
+		case strings.Contains(line, "Is it 1) aes?"):
+			questionY = i
+		case strings.Contains(line, "3) sha256"):
+			y = i
+		}
+	}
+	update(tea.MouseMsg{Y: questionY, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if questionY < 0 || m.State != StateQuestion || m.WrongAnswers != 0 {
+		t.Fatalf("clicking the question text should not answer, row %d wrong=%d", questionY, m.WrongAnswers)
+	}
+	update(tea.MouseMsg{Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if m.State == StateQuestion || !m.Trail.IsDone(2) {
+		t.Fatalf("clicking a choice should answer with it, row %d state=%s", y, gameStateName(m.State))
+	}
+}
+
+func TestChainedPackUnlocksAfterChoice(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "2", Choices: []string{"telnet", "ssh"}},
+			{ID: 2, Text: "Q2", Answer: "A2"},
+		},
+		FinalMessage: "EGG",
+	}
+	if _, err := cfg.SealChain(); err != nil {
+		t.Fatalf("SealChain: %v", err)
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m = next.(Model)
+	if m.CurrentQuestionIndex != 1 {
+		t.Fatalf("expected to advance to question 2, got index %d", m.CurrentQuestionIndex)
+	}
+	if got := m.currentQuestion(); got.Text != "Q2" || m.ChainError != "" {
+		t.Fatalf("expected question 2 to be decrypted, got %q (chain error %q)", got.Text, m.ChainError)
+	}
+}
+
+func TestMouseOnlyWhileChoicesAreOpen(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "A1"},
+			{ID: 2, Text: "Q2", Answer: "2", Choices: []string{"telnet", "ssh"}},
+			{ID: 3, Text: "Q3", Answer: "A3"},
+		},
+		FinalMessage: "EGG",
+	}
+	if _, err := cfg.SealChain(); err != nil {
+		t.Fatalf("SealChain: %v", err)
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	// The sealed choices are only known once question 2 is unlocked, so
+	// the mouse is turned on then.
+	update := func(msg tea.Msg) tea.Cmd {
+		next, cmd := m.Update(msg)
+		m = next.(Model)
+		return cmd
+	}
+	update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	if m.Mouse {
+		t.Fatalf("mouse should stay off for a question without choices")
+	}
+	m.Input.SetValue("A1")
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.State = StateQuestion // skip the transition
+	update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	if !m.Mouse {
+		t.Fatalf("mouse should be on once the unlocked question shows choices")
+	}
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m.State = StateQuestion
+	update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	if m.CurrentQuestionIndex != 2 || m.Mouse {
+		t.Fatalf("mouse should be off again after the choices, question %d", m.CurrentQuestionIndex+1)
+	}
+}
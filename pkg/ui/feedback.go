@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"fmt"
+
+	"ctf-tool/pkg/game"
+)
+
+// nearMissFeedback is shown for an input one typo beyond the tolerance.
+const nearMissFeedback = "SO CLOSE: ONE TYPO AWAY"
+
+// respond shows the pack's reply to a wrong guess, if it has one, and takes
+// off the points of a trap answer. Without a reply a near miss is pointed
+// out unless the pack hides them; otherwise the last feedback is cleared so
+// it never sticks to a different guess.
+func (m *Model) respond(q game.Question, guess *game.Guess) {
+	r, ok := guess.Response()
+	if !ok {
+		m.Feedback = ""
+		if !m.Config.HideNearMiss {
+			if _, near := game.FindNearMiss(guess.Input, q); near {
+				m.Feedback = nearMissFeedback
+			}
+		}
+		return
+	}
+	m.Feedback = m.personalize(r.Reply)
+	if r.Penalty > 0 {
+		m.Score.Penalize(q, r.Penalty)
+		m.Feedback += fmt.Sprintf(" (-%d POINTS)", r.Penalty)
+	}
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWrongAnswerFeedbackAndTrap(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Which hash?", Answer: "md4", Responses: []game.Response{
+				{Answer: game.Answer{Text: "md5"}, Reply: "Close, {{player}}, but think older."},
+				{Answer: game.Answer{Text: "sha1"}, Reply: "That's the decoy.", Penalty: 30},
+			}},
+			{ID: 2, Text: "Q2", Answer: "A2"},
+		},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+	m.Width, m.Height = 80, 24
+	m.SetPlayer("alice")
+
+	guess := func(input string) {
+		m.Input.SetValue(input)
+		next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = next.(Model)
+	}
+
+	guess("md5")
+	if m.Feedback != "Close, alice, but think older." || m.WrongAnswers != 1 {
+		t.Fatalf("unexpected feedback %q after %d wrong answers", m.Feedback, m.WrongAnswers)
+	}
+	if view := m.View(); !strings.Contains(view, "Close, alice, but think older.") {
+		t.Fatalf("feedback should be drawn below the theme:\n%s", view)
+	}
+
+	guess("sha1")
+	if m.Feedback != "That's the decoy. (-30 POINTS)" || len(m.Score.Penalties) != 1 {
+		t.Fatalf("trap not applied: %q %+v", m.Feedback, m.Score.Penalties)
+	}
+	if p := m.Progress(time.Now()); len(p.Penalties) != 1 || p.Penalties[0].Points != 30 {
+		t.Fatalf("penalties should be saved: %+v", p.Penalties)
+	}
+
+	guess("something else")
+	if m.Feedback != "" {
+		t.Fatalf("feedback should clear on a guess without a reply, got %q", m.Feedback)
+	}
+
+	guess("md5")
+	guess("md4")
+	if m.Feedback != "" {
+		t.Fatalf("feedback should clear with the next question, got %q", m.Feedback)
+	}
+}
+
+func TestNearMissFeedback(t *testing.T) {
+	cfg := &game.Config{
+		Questions:    []game.Question{{ID: 1, Text: "Q1", Answer: "keyboard"}},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+	m.Input.SetValue("kybrd")
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if m.Feedback != nearMissFeedback || m.State != StateQuestion {
+		t.Fatalf("expected near-miss feedback, got %q in state %s", m.Feedback, gameStateName(m.State))
+	}
+
+	cfg.HideNearMiss = true
+	m = NewModel(cfg)
+	m.State = StateQuestion
+	m.Input.SetValue("kybrd")
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if m.Feedback != "" {
+		t.Fatalf("hide_near_miss should keep quiet, got %q", m.Feedback)
+	}
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func flagConfig() *game.Config {
+	return &game.Config{
+		Questions:    []game.Question{{ID: 1, Text: "Q1", Answer: "A1"}, {ID: 2, Text: "Q2", Answer: "A2"}},
+		FinalMessage: "Your flag: {{flag}}",
+		FlagTemplate: "FLAG{egg_{{hmac8}}}",
+		FlagSecret:   "s3cret",
+	}
+}
+
+// answerAll types and submits answers one question after the other.
+func answerAll(t *testing.T, m Model, answers ...string) Model {
+	t.Helper()
+	for _, a := range answers {
+		m.State = StateQuestion
+		m.Input.SetValue(a)
+		next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = next.(Model)
+	}
+	return m
+}
+
+func TestFinaleShowsPlayerFlag(t *testing.T) {
+	cfg := flagConfig()
+	m := NewModel(cfg)
+	m.SetPlayer("alice")
+	m = answerAll(t, m, "A1", "A2")
+	if m.State != StateSuccess {
+		t.Fatalf("state = %s, want success", gameStateName(m.State))
+	}
+	want := "Your flag: " + game.RenderFlag(cfg.FlagTemplate, cfg.FlagSecret, "alice")
+	if got := m.finalMessage(); got != want {
+		t.Fatalf("finalMessage = %q, want %q", got, want)
+	}
+
+	anon := answerAll(t, NewModel(cfg), "A1", "A2")
+	if anon.finalMessage() == m.finalMessage() {
+		t.Fatalf("anonymous players must get a flag of their own")
+	}
+}
+
+func TestFinaleWithholdsFlagUntilEverythingIsSolved(t *testing.T) {
+	m := NewModel(flagConfig())
+	m.SetPlayer("alice")
+	m = answerAll(t, m, "A1")
+	m.startFinale()
+	if got := m.finalMessage(); strings.Contains(got, "FLAG{") || !strings.Contains(got, noFlagText) {
+		t.Fatalf("finalMessage = %q, want no flag with a question unsolved", got)
+	}
+	if got := m.finalScoreLine(); strings.Contains(got, "FINAL SCORE") {
+		t.Fatalf("finalScoreLine = %q, want no score with a question unsolved", got)
+	}
+}
+
+func TestDemoKeysOnlyWorkInShowcase(t *testing.T) {
+	m := NewModel(flagConfig())
+	m.State = StateQuestion
+	m.WrongAnswers, m.HintsRevealed = 1, 1
+	for _, key := range []tea.KeyType{tea.KeyF2, tea.KeyF3} {
+		next, _ := m.Update(tea.KeyMsg{Type: key})
+		m = next.(Model)
+	}
+	if m.AutoDemo || m.CurrentQuestionIndex != 0 || m.WrongAnswers != 1 || m.HintsRevealed != 1 {
+		t.Fatalf("F2/F3 changed a game: demo=%t question=%d wrong=%d hints=%d", m.AutoDemo, m.CurrentQuestionIndex, m.WrongAnswers, m.HintsRevealed)
+	}
+}
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// nextQuestion picks where to go after the current question: the next index
+// of a linear pack, or the only available question of a graph. choose is set
+// when the player has to pick among several, and always on a category board;
+// next is -1 when the game is over.
+func (m *Model) nextQuestion() (next int, choose bool) {
+	if m.Config.Linear() {
+		if m.CurrentQuestionIndex+1 >= len(m.Config.Questions) {
+			return -1, false
+		}
+		return m.CurrentQuestionIndex + 1, false
+	}
+
+	if m.Config.Finished(m.Trail) {
+		return -1, false
+	}
+	if m.Config.IsBoard() {
+		return -1, true
+	}
+	available := m.Config.Available(m.Trail)
+	if len(available) == 1 {
+		return available[0], false
+	}
+	return -1, true
+}
+
+// beginQuestions leaves the intro for the name prompt if the pack asks for
+// one, then for the first question, the category board, or the select
+// screen of a graph with several starting points.
+func (m *Model) beginQuestions() {
+	if m.needsPlayer() {
+		m.askPlayer()
+		return
+	}
+	m.TypewriterIndex = 0
+	if m.Config.IsBoard() {
+		m.openSelect()
+		return
+	}
+	if m.Config.IsGraph() {
+		available := m.Config.Available(m.Trail)
+		if len(available) > 1 {
+			m.openSelect()
+			return
+		}
+		if len(available) == 1 {
+			m.CurrentQuestionIndex = available[0]
+		}
+	}
+	m.State = StateQuestion
+}
+
+// openSelect lets the player pick the next question: on the category board,
+// or on the select screen of a graph.
+func (m *Model) openSelect() {
+	if m.Config.IsBoard() {
+		m.openBoard()
+		return
+	}
+	m.State = StateSelect
+	m.SelectCursor = 0
+}
+
+// updateSelect handles the question select screen.
+func (m *Model) updateSelect(msg tea.Msg) tea.Cmd {
+	available := m.Config.Available(m.Trail)
+
+	switch msg := msg.(type) {
+	case game.TickMsg:
+		now := time.Time(msg)
+		if m.deadlinePassed(now) {
+			m.TimedOut = true
+			return m.startFinale()
+		}
+		// No theme is active here to keep the tick loop alive.
+		return tick()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.SelectCursor > 0 {
+				m.SelectCursor--
+			}
+		case "down", "j":
+			if m.SelectCursor < len(available)-1 {
+				m.SelectCursor++
+			}
+		case "enter":
+			if m.SelectCursor < len(available) {
+				return m.selectQuestion(available[m.SelectCursor])
+			}
+		default:
+			var n int
+			if _, err := fmt.Sscanf(msg.String(), "%d", &n); err == nil && n >= 1 && n <= len(available) {
+				return m.selectQuestion(available[n-1])
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Model) selectQuestion(index int) tea.Cmd {
+	m.CurrentQuestionIndex = index
+	m.resetQuestionState()
+	m.State = StateQuestion
+	return m.PickRandomTheme()
+}
+
+func (m *Model) selectView() string {
+	available := m.Config.Available(m.Trail)
+
+	var b strings.Builder
+	b.WriteString("CHOOSE YOUR NEXT TARGET\n\n")
+	for i, index := range available {
+		q := m.Config.Questions[index]
+		cursor := "  "
+		if i == m.SelectCursor {
+			cursor = "> "
+		}
+		label := m.personalize(questionLabel(q))
+		if q.Optional {
+			label += " (side puzzle)"
+		}
+		b.WriteString(fmt.Sprintf("%s[%d] %s\n", cursor, i+1, label))
+	}
+	b.WriteString("\n" + m.statusLine() + "\n")
+	b.WriteString("[UP/DOWN] SELECT   [ENTER] OPEN")
+
+	return lipgloss.NewStyle().
+		Width(m.Width).
+		Height(m.Height).
+		Align(lipgloss.Center, lipgloss.Center).
+		Foreground(lipgloss.Color("#00FF00")).
+		Render(b.String())
+}
+
+// questionLabel names a question on the select screen: its title, or the
+// first line of its text.
+func questionLabel(q game.Question) string {
+	if q.Title != "" {
+		return q.Title
+	}
+	line, _, _ := strings.Cut(q.Text, "\n")
+	return trimForDebug(line, 48)
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestQuestionGraphSelectScreen(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Start", Answer: "A1"},
+			{ID: 2, Title: "Left path", Text: "Q2", Answer: "A2", Requires: []int{1}},
+			{ID: 3, Title: "Right path", Text: "Q3", Answer: "A3", Requires: []int{1}},
+			{ID: 4, Title: "Bonus", Text: "Q4", Answer: "A4", Requires: []int{1}, Optional: true},
+		},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.ActiveBoot = nil
+	m.Width, m.Height = 80, 24
+
+	send := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	answer := func(input string) {
+		m.State = StateQuestion
+		m.Input.SetValue(input)
+		send(tea.KeyMsg{Type: tea.KeyEnter})
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.CurrentQuestionIndex != 0 {
+		t.Fatalf("expected the single start question, state=%s", gameStateName(m.State))
+	}
+
+	answer("A1")
+	if m.State != StateSelect {
+		t.Fatalf("expected the select screen, state=%s", gameStateName(m.State))
+	}
+	if view := m.View(); !strings.Contains(view, "Right path") || !strings.Contains(view, "(side puzzle)") {
+		t.Fatalf("select screen should list the open questions:\n%s", view)
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.CurrentQuestionIndex != 2 {
+		t.Fatalf("expected question 3, state=%s index=%d", gameStateName(m.State), m.CurrentQuestionIndex)
+	}
+
+	answer("A3")
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if m.CurrentQuestionIndex != 1 {
+		t.Fatalf("expected the digit to pick question 2, index=%d", m.CurrentQuestionIndex)
+	}
+
+	answer("A2")
+	if m.State != StateSuccess {
+		t.Fatalf("only the side puzzle is left, expected the finale, state=%s", gameStateName(m.State))
+	}
+}
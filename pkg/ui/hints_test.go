@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHintsRevealProgressively(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{{
+			ID:     1,
+			Text:   "Q1",
+			Answer: "A1",
+			Hints: []game.Hint{
+				{Text: "first"},
+				{Text: "second", OnRequest: true},
+				{Text: "third", AfterSeconds: 60},
+			},
+		}},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	send := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	shown := func() string {
+		q := m.currentQuestion()
+		return m.applyHints(&q)
+	}
+
+	start := time.Now()
+	send(game.TickMsg(start))
+	if got := shown(); got != "" {
+		t.Fatalf("expected no hints before a wrong answer, got %q", got)
+	}
+
+	m.Input.SetValue("nope")
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := shown(); got != "first" {
+		t.Fatalf("expected first hint after a wrong answer, got %q", got)
+	}
+	if !m.hintOnRequest(m.currentQuestion()) {
+		t.Fatalf("expected the second hint to be requestable")
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyF4})
+	if got := shown(); got != "first | second" {
+		t.Fatalf("expected requested hint to be revealed, got %q", got)
+	}
+
+	send(game.TickMsg(start.Add(61 * time.Second)))
+	if got := shown(); got != "first | second | third" {
+		t.Fatalf("expected timed hint to be revealed, got %q", got)
+	}
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxLevelEntry leaves room for a separator in a typed level code.
+const maxLevelEntry = 10
+
+// typeLevelCode edits the level code typed on the intro screen. It reports
+// whether the key was used.
+func (m *Model) typeLevelCode(msg tea.KeyMsg) bool {
+	switch msg.Type {
+	case tea.KeyBackspace:
+		if m.LevelEntry == "" {
+			return false
+		}
+		runes := []rune(m.LevelEntry)
+		m.LevelEntry = string(runes[:len(runes)-1])
+		return true
+	case tea.KeyRunes:
+		typed := game.NormalizeLevelCode(string(msg.Runes))
+		if typed == "" {
+			return false
+		}
+		if len(m.LevelEntry)+len(typed) <= maxLevelEntry {
+			m.LevelEntry += typed
+		}
+		return true
+	}
+	return false
+}
+
+// enterLevelCode jumps to the question opened by the typed level code,
+// after asking for the player's name if the pack wants one.
+func (m *Model) enterLevelCode() {
+	code := m.LevelEntry
+	m.LevelEntry = ""
+
+	index, err := m.Config.JumpToLevel(code)
+	if err != nil {
+		m.BootStatus = "ACCESS DENIED: unknown level code."
+		return
+	}
+
+	// A level code replaces any saved game, and is saved with the new one
+	// so a chained pack can open the question again on resume.
+	m.PendingResume = nil
+	m.levelCode = code
+	m.CurrentQuestionIndex = index
+	m.resetQuestionState()
+	m.beginQuestions()
+}
+
+// levelPrompt is the intro screen line for entering a level code.
+func (m *Model) levelPrompt() string {
+	if !m.Config.LevelCodes {
+		return ""
+	}
+	if m.LevelEntry == "" {
+		return "[TYPE A LEVEL CODE TO CONTINUE]"
+	}
+	return "LEVEL CODE: " + m.LevelEntry + "_"
+}
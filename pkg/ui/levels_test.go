@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestLevelCodeAtIntroJumpsToQuestion(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "A1"},
+			{ID: 2, Text: "Q2", Answer: "A2"},
+		},
+		FinalMessage: "EGG",
+		LevelCodes:   true,
+		LevelSecret:  "s3cret",
+	}
+	cfg.AssignLevels()
+	m := NewModel(cfg)
+	m.ActiveBoot = nil
+
+	send := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zzzz-zzzz")})
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateIntro || !strings.Contains(m.BootStatus, "unknown level code") {
+		t.Fatalf("expected a wrong code to be rejected, state=%s status=%q", gameStateName(m.State), m.BootStatus)
+	}
+
+	for _, r := range strings.ToLower(cfg.Questions[1].Level) {
+		send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.CurrentQuestionIndex != 1 {
+		t.Fatalf("expected to jump to question 2, state=%s index=%d", gameStateName(m.State), m.CurrentQuestionIndex)
+	}
+	if got := m.hud().LevelCode; got != cfg.Questions[1].Level {
+		t.Fatalf("expected the HUD to show the level code, got %q", got)
+	}
+}
+
+func TestLevelCodeInChainedPackSurvivesResume(t *testing.T) {
+	raw, _, err := game.Pack([]byte(`{
+		"level_codes": true,
+		"level_secret": "s3cret",
+		"player_prompt": "Team?",
+		"questions": [
+			{"id": 1, "text": "Q1", "answers": ["a1"]},
+			{"id": 2, "text": "Q2", "answers": ["a2"]},
+			{"id": 3, "text": "Q3", "answers": ["a3"]},
+			{"id": 4, "text": "Q4", "answers": ["a4"]}
+		],
+		"final_message": "EGG"
+	}`), game.PackOptions{Chain: true})
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	load := func() *game.Config {
+		cfg, err := game.ParseConfig(raw)
+		if err != nil {
+			t.Fatalf("ParseConfig: %v", err)
+		}
+		return cfg
+	}
+	path := filepath.Join(t.TempDir(), "progress.json")
+
+	m := NewModel(load())
+	m.EnableSaving(path)
+	m.ActiveBoot = nil
+	send := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(game.LevelCode([]byte("s3cret"), 2))})
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StatePlayer {
+		t.Fatalf("a level code should still ask for the player, state=%s", gameStateName(m.State))
+	}
+	m.Input.SetValue("red team")
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.currentQuestion(); m.State != StateQuestion || got.Text != "Q3" {
+		t.Fatalf("expected question 3 after the name, state=%s text=%q", gameStateName(m.State), got.Text)
+	}
+	m.Input.SetValue("a3")
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	m.State = StateQuestion // skip the transition animation
+	send(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.SaveError != "" {
+		t.Fatalf("save failed: %s", m.SaveError)
+	}
+
+	resumed := NewModel(load())
+	resumed.EnableSaving(path)
+	if resumed.PendingResume == nil {
+		t.Fatalf("expected a saved game to be offered")
+	}
+	resumed.resume(*resumed.PendingResume)
+	if got := resumed.currentQuestion(); resumed.CurrentQuestionIndex != 3 || got.Text != "Q4" {
+		t.Fatalf("expected question 4 to be unlocked on resume, index=%d text=%q (chain error %q)", resumed.CurrentQuestionIndex, got.Text, resumed.ChainError)
+	}
+}
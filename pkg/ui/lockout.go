@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"bufio"
+	"ctf-tool/pkg/game"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// LockoutMsg is a lockout another game of the same web client started.
+type LockoutMsg game.Lockout
+
+// lockedOut reports whether guesses are refused at now.
+func (m *Model) lockedOut(now time.Time) bool {
+	return m.Lockout.Remaining(now) > 0
+}
+
+// strike counts a wrong answer against the pack's lockout rules and starts
+// a cooldown once there are too many.
+func (m *Model) strike(now time.Time) {
+	rules, ok := m.Config.LockoutRules()
+	if !ok {
+		return
+	}
+	if cooldown := m.Lockout.Fail(rules, now); cooldown > 0 {
+		m.Input.Reset()
+	}
+	m.reportLockout()
+}
+
+// clearLockout ends the lockout and its counts once a question is solved.
+func (m *Model) clearLockout() {
+	m.Lockout = game.Lockout{}
+	if _, ok := m.Config.LockoutRules(); ok {
+		m.reportLockout()
+	}
+}
+
+// ImposeLockout locks the game out for at least lockout l, for the web
+// server to carry a lockout over from another session of the same client.
+func (m *Model) ImposeLockout(l game.Lockout) {
+	m.Lockout.Merge(l)
+}
+
+// RelayLockouts sends a LockoutMsg for every "lockout " line the web server
+// writes on r (see -lockouts-fd) until r is closed.
+func RelayLockouts(r io.Reader, send func(tea.Msg)) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		text, ok := strings.CutPrefix(scanner.Text(), "lockout ")
+		if !ok {
+			continue
+		}
+		if l, err := game.ParseLockout(text); err == nil {
+			send(LockoutMsg(l))
+		}
+	}
+}
+
+// reportLockout tells the web server, which passes a pipe for this (see
+// -events-fd), the lockout state after every change.
+func (m *Model) reportLockout() {
+	if m.Events != nil {
+		fmt.Fprintf(m.Events, "lockout %s\n", game.FormatLockout(m.Lockout))
+	}
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestLockoutBlocksGuessesAndSurvivesRestart(t *testing.T) {
+	cfg := &game.Config{
+		Questions:    []game.Question{{ID: 1, Text: "Q1", Answer: "keyboard"}},
+		FinalMessage: "EGG",
+		Lockout:      &game.LockoutRules{After: 2, Cooldown: 60},
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+	var events strings.Builder
+	m.Events = &events
+
+	guess := func(input string) {
+		m.Input.SetValue(input)
+		next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = next.(Model)
+	}
+	guess("mouse")
+	guess("monitor")
+	reported := strings.Split(strings.TrimSpace(events.String()), "\n")
+	if !m.lockedOut(time.Now()) || len(reported) != 2 || reported[0] != "lockout 1,0,0" || !strings.HasPrefix(reported[1], "lockout 0,1,") {
+		t.Fatalf("expected a lockout after two wrong answers, events %q", events.String())
+	}
+	if got := m.hud().String(); !strings.Contains(got, "LOCKED 1:00") && !strings.Contains(got, "LOCKED 0:59") {
+		t.Fatalf("HUD should show the lockout: %q", got)
+	}
+
+	guess("keyboard")
+	if m.State != StateQuestion || m.WrongAnswers != 2 {
+		t.Fatalf("guesses must be ignored during a lockout, state=%s wrong=%d", gameStateName(m.State), m.WrongAnswers)
+	}
+
+	// Neither resuming nor starting over ends the lockout.
+	saved := m.Progress(time.Now())
+	resumed := NewModel(cfg)
+	resumed.resume(saved)
+	if !resumed.lockedOut(time.Now()) {
+		t.Fatalf("lockout should survive a restart")
+	}
+	fresh := NewModel(cfg)
+	fresh.PendingResume = &saved
+	fresh.discardProgress()
+	if !fresh.lockedOut(time.Now()) || fresh.Lockout.Count != 1 {
+		t.Fatalf("starting over should keep the lockout: %+v", fresh.Lockout)
+	}
+
+	m.Lockout.Until = time.Now().Add(-time.Second)
+	guess("keyboard")
+	if m.State == StateQuestion || m.Lockout != (game.Lockout{}) {
+		t.Fatalf("expected the solve to go through and clear the lockout, state=%s lockout=%+v", gameStateName(m.State), m.Lockout)
+	}
+}
+
+func TestImposedLockoutOutlastsSavedGame(t *testing.T) {
+	cfg := &game.Config{
+		Questions:    []game.Question{{ID: 1, Text: "Q1", Answer: "keyboard"}},
+		FinalMessage: "EGG",
+		Lockout:      &game.LockoutRules{After: 2, Cooldown: 60},
+	}
+	m := NewModel(cfg)
+	m.ImposeLockout(game.Lockout{Strikes: 1, Count: 2, Until: time.Now().Add(time.Minute)})
+	m.resume(game.Progress{Lockout: game.Lockout{Strikes: 0, Count: 1}})
+	if !m.lockedOut(time.Now()) || m.Lockout.Count != 2 || m.Lockout.Strikes != 1 {
+		t.Fatalf("an imposed lockout must survive resuming, got %+v", m.Lockout)
+	}
+}
+
+func TestLockoutFromAnotherSessionStopsGuessing(t *testing.T) {
+	cfg := &game.Config{
+		Questions:    []game.Question{{ID: 1, Text: "Q1", Answer: "keyboard"}},
+		FinalMessage: "EGG",
+		Lockout:      &game.LockoutRules{After: 2, Cooldown: 60},
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	var msgs []tea.Msg
+	until := time.Now().Add(time.Minute)
+	RelayLockouts(strings.NewReader("noise\nlockout "+game.FormatLockout(game.Lockout{Count: 1, Until: until})+"\n"), func(msg tea.Msg) {
+		msgs = append(msgs, msg)
+	})
+	if len(msgs) != 1 {
+		t.Fatalf("expected one relayed lockout, got %v", msgs)
+	}
+	next, _ := m.Update(msgs[0])
+	m = next.(Model)
+
+	m.Input.SetValue("keyboard")
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if m.State != StateQuestion || m.Lockout.Count != 1 {
+		t.Fatalf("a lockout from another session must stop guessing, state=%s lockout=%+v", gameStateName(m.State), m.Lockout)
+	}
+}
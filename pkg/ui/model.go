@@ -7,6 +7,7 @@ import (
 	"ctf-tool/pkg/ui/theme"
 	"ctf-tool/pkg/ui/transition"
 	"fmt"
+	"io"
 	"math/rand"
 	"regexp"
 	"strings"
@@ -22,6 +23,18 @@ var sgrTextPattern = regexp.MustCompile(`\[[0-9;]*m`)
 
 const transitionWatchdogTicks = 1800
 
+const lockedQuestionText = "[ENCRYPTED] Solve the previous question to decrypt this transmission."
+
+// noFlagText stands in for the flag when the finale was reached without
+// solving every question.
+const noFlagText = "[NO FLAG] Not every question was solved."
+
+const hintSeparator = " | "
+
+// saveInterval is how often progress is saved while a question is open, so
+// killed web sessions lose little time.
+const saveInterval = 5 * time.Second
+
 func tick() tea.Cmd {
 	return tea.Tick(time.Millisecond*33, func(t time.Time) tea.Msg {
 		return game.TickMsg(t)
@@ -47,6 +60,12 @@ func gameStateName(state GameState) string {
 		return "question"
 	case StateTransition:
 		return "transition"
+	case StateSelect:
+		return "select"
+	case StateBoard:
+		return "board"
+	case StatePlayer:
+		return "player"
 	case StateSuccess:
 		return "success"
 	default:
@@ -58,6 +77,20 @@ type Model struct {
 	Config *game.Config
 	State  GameState
 
+	// Seed is the draw of a randomized pack; Config holds the questions
+	// drawn from source with it.
+	Seed   int64
+	source *game.Config
+
+	// Player is the name or team the player gave (see SetPlayer); empty
+	// while unknown. anonID stands in for it in flags and seeds: the session
+	// token, or a random id kept in saved progress. seedFixed is set once the
+	// seed no longer follows the player.
+	Player      string
+	anonID      string
+	seedFixed   bool
+	playerAsked bool
+
 	// Terminal capabilities used for compatibility-aware theme selection.
 	Caps caps.Capabilities
 
@@ -77,6 +110,40 @@ type Model struct {
 	DebugDumpRequested    bool
 	DebugDumpTrigger      string
 
+	// Score holds the result of every solved question.
+	Score game.Scorecard
+
+	// Trail and SelectCursor drive question graphs: the questions done and
+	// branches opened so far, and the highlighted entry of the select screen.
+	// BoardCol and BoardRow are the highlighted cell of the category board.
+	Trail        game.Trail
+	SelectCursor int
+	BoardCol     int
+	BoardRow     int
+
+	// LastMatch records which accepted answer (alias) solved the most recently
+	// answered question, for the debug snapshot.
+	LastMatch           game.Answer
+	LastMatchQuestionID int
+	ChainError          string
+
+	// Feedback is the pack's reply to the last wrong answer. It is drawn
+	// below any theme.
+	Feedback string
+
+	// The parts of a multi-part question: what was typed into each (the
+	// focused one is edited in Input), which are solved, and which has
+	// focus. partMatches holds the accepted answer of every solved part.
+	PartValues  []string
+	PartSolved  []bool
+	PartFocus   int
+	partMatches []game.Answer
+
+	// ChoiceIndex is the selected choice of a multiple-choice question.
+	ChoiceIndex int
+	// Mouse is whether mouse reporting is on (see syncMouse).
+	Mouse bool
+
 	// Animation State
 	TypewriterIndex int
 	FinaleTheme     theme.Theme
@@ -88,8 +155,48 @@ type Model struct {
 	Width  int
 	Height int
 
-	// Feedback
-	ShowHint bool
+	// Feedback. HintsRevealed counts how much of the current question's hint
+	// ladder is visible; ShowHint reveals the whole ladder (showcase mode).
+	// QuestionStart is set on the first tick spent on a question and drives
+	// time-based hints.
+	ShowHint      bool
+	HintsRevealed int
+	QuestionStart time.Time
+
+	// Timers. GameStart is set on the first tick spent on any question and
+	// LastTick on every tick, so the HUD can show remaining time.
+	// QuestionExpired is set once the current question's time_limit has been
+	// handled; TimedOut once a timer has ended the game.
+	GameStart       time.Time
+	LastTick        time.Time
+	QuestionExpired bool
+	TimedOut        bool
+
+	// Saving. Progress is written to SavePath (empty disables saving) after
+	// every change and periodically while a question is open. PendingResume
+	// is a saved game offered on the intro screen.
+	SavePath      string
+	PackID        string
+	PendingResume *game.Progress
+	SaveError     string
+	lastSave      time.Time
+	// inputs holds the accepted input per finished question of a chained
+	// pack ("" otherwise), so a resumed game can unlock the same questions.
+	inputs []string
+	// Lockout is the guessing cooldown under the pack's lockout rules. It is
+	// saved with the game and kept when the player starts over.
+	Lockout game.Lockout
+	// Events receives one line per lockout when set (see strike).
+	Events io.Writer
+	// questionOffset and gameOffset carry resumed elapsed time into the
+	// clocks started on the next tick.
+	questionOffset time.Duration
+	gameOffset     time.Duration
+
+	// LevelEntry is the level code being typed on the intro screen.
+	LevelEntry string
+	// levelCode is the level code the game was started from, if any.
+	levelCode string
 
 	// Demo
 	AutoDemo bool
@@ -99,13 +206,19 @@ type Model struct {
 	showcaseTransitionCursor int
 }
 
+// Answer input settings, restored after the name prompt.
+const (
+	answerPlaceholder = "Type answer..."
+	answerCharLimit   = 156
+)
+
 func NewModel(config *game.Config) Model {
 	rand.Seed(time.Now().UnixNano())
 
 	ti := textinput.New()
-	ti.Placeholder = "Type answer..."
+	ti.Placeholder = answerPlaceholder
 	ti.Focus()
-	ti.CharLimit = 156
+	ti.CharLimit = answerCharLimit
 	ti.Width = 30
 
 	m := Model{
@@ -113,12 +226,33 @@ func NewModel(config *game.Config) Model {
 		State:  StateIntro,
 		Caps:   caps.Detect(),
 		Input:  ti,
+		Score:  game.NewScorecard(config.ScoreRules()),
+		PackID: config.Fingerprint(),
+		source: config,
+
+		anonID: game.NewPlayerID(),
 	}
+	m.draw(rand.Int63())
 	m.PickRandomBootIntro()
 	m.PickRandomTheme()
 	return m
 }
 
+// SetSeed draws the player's questions from a randomized pack with seed,
+// which then stays put when the player changes. It must be called before the
+// first question.
+func (m *Model) SetSeed(seed int64) {
+	m.seedFixed = true
+	m.draw(seed)
+}
+
+func (m *Model) draw(seed int64) {
+	m.Seed = seed
+	if m.source.Randomized() {
+		m.Config = m.source.Sample(seed)
+	}
+}
+
 func (m *Model) EnableShowcase() {
 	m.Showcase = true
 	m.AutoDemo = true
@@ -131,7 +265,7 @@ func (m *Model) EnableShowcase() {
 	// Prefer a stable first question.
 	if len(m.Config.Questions) > 0 {
 		m.CurrentQuestionIndex = 0
-		m.TypewriterIndex = len(m.Config.Questions[0].Text)
+		m.TypewriterIndex = len(m.currentQuestion().Text)
 	}
 
 	// Skip intro for quick visual inspection.
@@ -167,6 +301,9 @@ func (m *Model) PickRandomBootIntro() tea.Cmd {
 
 	m.ActiveBoot = candidates[rand.Intn(len(candidates))]
 	m.BootStatus = fmt.Sprintf("Cinematic boot check: OK - profile \"%s\"", m.ActiveBoot.Name())
+	if aware, ok := m.ActiveBoot.(boot.PlayerAware); ok && m.Player != "" {
+		aware.SetPlayer(m.Player)
+	}
 	return nil
 }
 
@@ -273,20 +410,17 @@ func (m *Model) StartShowcaseTransition() tea.Cmd {
 	}
 
 	// 1. Capture Old View (fully visible text).
-	q := m.Config.Questions[m.CurrentQuestionIndex]
+	q := m.currentQuestion()
 	displayQ := q
 	displayQ.Text = q.Text
-	hint := ""
-	if m.ShowHint {
-		hint = q.Hint
-	}
-	oldView := m.safeThemeView(&displayQ, m.themeInputValue(), hint)
+	hint := m.applyHints(&displayQ)
+	oldView := m.safeThemeView(&displayQ, m.themeInputs(), hint, m.Height)
 
 	// 2. Pick next compatible theme.
 	m.pickNextCompatibleTheme()
 
 	// 3. Capture New View (same question, different theme).
-	newView := m.safeThemeView(&displayQ, m.themeInputValue(), hint)
+	newView := m.safeThemeView(&displayQ, m.themeInputs(), hint, m.Height)
 
 	// 4. Create next transition (sequential).
 	m.State = StateTransition
@@ -308,57 +442,40 @@ func (m *Model) StartShowcaseTransition() tea.Cmd {
 
 func (m *Model) StartTransition() tea.Cmd {
 	// 1. Capture Old View
-	q := m.Config.Questions[m.CurrentQuestionIndex]
+	q := m.currentQuestion()
 	visibleText := q.Text
 	if m.TypewriterIndex < len(q.Text) {
 		visibleText = q.Text[:m.TypewriterIndex] + "█"
 	}
 	displayQ := q
 	displayQ.Text = visibleText
-	hint := ""
-	if m.ShowHint {
-		hint = q.Hint
-	}
+	hint := m.applyHints(&displayQ)
 
-	oldView := m.safeThemeView(&displayQ, m.themeInputValue(), hint)
+	oldView := m.safeThemeView(&displayQ, m.themeInputs(), hint, m.Height)
 
 	// 2. Advance State
-	m.CurrentQuestionIndex++
+	next, choose := m.nextQuestion()
 	// Check for game completion
-	if m.CurrentQuestionIndex >= len(m.Config.Questions) {
-		m.State = StateSuccess
-		m.Input.Reset()
-
-		// Initialize Finale theme if available
-		m.FinaleTheme = nil
-		for _, constructor := range theme.Registry {
-			tmp := constructor()
-			if tmp.Name() == "Antigravity (Finale)" {
-				m.FinaleTheme = tmp
-				if initCmd := m.FinaleTheme.Init(); initCmd != nil {
-					return initCmd
-				}
-				break
-			}
-		}
-
+	if next < 0 && !choose {
+		return m.startFinale()
+	}
+	m.resetQuestionState()
+	if choose {
+		m.openSelect()
 		return nil
 	}
+	m.CurrentQuestionIndex = next
 
 	// 3. Pick New Theme
 	m.PickRandomTheme()
 
 	// 4. Capture New View (Preview)
-	m.Input.Reset()
-	m.ShowHint = false
-	m.WrongAnswers = 0
-	m.TypewriterIndex = 0
 
-	newQ := m.Config.Questions[m.CurrentQuestionIndex]
+	newQ := m.currentQuestion()
 	newDisplayQ := newQ
 	newDisplayQ.Text = "█"
 
-	newView := m.safeThemeView(&newDisplayQ, m.themeInputValue(), "")
+	newView := m.safeThemeView(&newDisplayQ, m.themeInputs(), "", m.Height)
 
 	// 5. Create Transition
 	m.State = StateTransition
@@ -378,6 +495,71 @@ func (m *Model) StartTransition() tea.Cmd {
 	return nil
 }
 
+// solve finishes question q with the accepted answer match, given as input,
+// and starts the transition to what comes next.
+func (m *Model) solve(q game.Question, match game.Answer, input string) tea.Cmd {
+	m.LastMatch = match
+	m.LastMatchQuestionID = q.ID
+	m.Score.Record(q, m.WrongAnswers, m.HintsRevealed, m.questionElapsed(time.Now()))
+	m.clearLockout()
+	m.Trail.Finish(q, match)
+	m.recordInput(input)
+	m.unlockNext(input)
+	return m.StartTransition()
+}
+
+// resetQuestionState clears the per-question feedback before another
+// question is shown.
+func (m *Model) resetQuestionState() {
+	m.Input.Reset()
+	m.ShowHint = false
+	m.WrongAnswers = 0
+	m.HintsRevealed = 0
+	m.QuestionStart = time.Time{}
+	m.QuestionExpired = false
+	m.TypewriterIndex = 0
+	m.Feedback = ""
+	m.PartValues, m.PartSolved, m.partMatches, m.PartFocus = nil, nil, nil, 0
+	m.ChoiceIndex = 0
+}
+
+// submitAnswer checks the typed answer to q. A wrong one gets the pack's
+// reply, counts against the lockout and may reveal hints.
+func (m *Model) submitAnswer(q game.Question) tea.Cmd {
+	guess := game.NewGuess(m.Input.Value(), q)
+	if match, ok := guess.Answer(); ok {
+		return m.solve(q, match, guess.Input)
+	}
+	m.WrongAnswers++
+	m.respond(q, guess)
+	m.strike(time.Now())
+	m.updateHints(time.Now(), false)
+	m.Input.Reset()
+	return nil
+}
+
+// startFinale switches to the success screen. It is also used when a timer
+// ends the game early (TimedOut).
+func (m *Model) startFinale() tea.Cmd {
+	m.State = StateSuccess
+	m.Input.Reset()
+
+	// Initialize Finale theme if available
+	m.FinaleTheme = nil
+	for _, constructor := range theme.Registry {
+		tmp := constructor()
+		if tmp.Name() == "Antigravity (Finale)" {
+			m.FinaleTheme = tmp
+			if initCmd := m.FinaleTheme.Init(); initCmd != nil {
+				return initCmd
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
 func (m Model) Init() tea.Cmd {
 	var cmds []tea.Cmd
 	cmds = append(cmds, textinput.Blink, tick())
@@ -390,6 +572,7 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
+	before := m.progressMark()
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -405,6 +588,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		switch msg.Type {
 		case tea.KeyCtrlC, tea.KeyCtrlX, tea.KeyEsc, tea.KeyF12:
+			m.saveProgress(time.Now())
 			return m, tea.Quit
 		case tea.KeyF1:
 			// Theme cycling
@@ -414,23 +598,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, m.PickRandomTheme())
 			}
 		case tea.KeyF2:
-			// Transition cycling
+			// Transition cycling. Only the showcase has questions to spare;
+			// in a game it would skip one without answering.
 			if m.Showcase {
 				cmds = append(cmds, m.StartShowcaseTransition())
-			} else {
-				// Force Transition (stay on same Q)
-				m.CurrentQuestionIndex--
-				if m.CurrentQuestionIndex < 0 {
-					m.CurrentQuestionIndex = 0
-				}
-				cmds = append(cmds, m.StartTransition())
 			}
 		case tea.KeyF3:
-			m.AutoDemo = !m.AutoDemo
+			if m.Showcase {
+				m.AutoDemo = !m.AutoDemo
+			}
+		case tea.KeyF4:
+			if m.State == StateQuestion {
+				m.updateHints(time.Now(), true)
+			}
 		}
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
+	case LockoutMsg:
+		m.ImposeLockout(game.Lockout(msg))
+		if m.lockedOut(time.Now()) {
+			m.Input.Reset()
+		}
 	}
 
 	// Auto Demo Logic
@@ -439,12 +628,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.DemoTick++
 			if m.DemoTick > 150 { // ~5 seconds
 				m.DemoTick = 0
-				if m.State == StateQuestion {
-					if m.Showcase {
-						cmds = append(cmds, m.StartShowcaseTransition())
-					} else {
-						cmds = append(cmds, m.StartTransition())
-					}
+				if m.State == StateQuestion && m.Showcase {
+					cmds = append(cmds, m.StartShowcaseTransition())
 				}
 			}
 		}
@@ -467,9 +652,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, tick())
 			}
 		}
-		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter {
-			m.State = StateQuestion
-			m.TypewriterIndex = 0
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			switch {
+			case keyMsg.Type == tea.KeyEnter && m.LevelEntry != "":
+				m.enterLevelCode()
+			case m.Config.LevelCodes && m.typeLevelCode(keyMsg):
+			case keyMsg.Type == tea.KeyEnter && m.PendingResume != nil:
+				cmds = append(cmds, m.resume(*m.PendingResume))
+			case keyMsg.Type == tea.KeyEnter:
+				m.beginQuestions()
+			case m.PendingResume != nil && strings.EqualFold(keyMsg.String(), "n"):
+				m.discardProgress()
+			}
 		}
 
 	case StateTransition:
@@ -512,31 +706,48 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, tCmd)
 		}
 
-		// Input Handling
-		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter {
-			currentQ := m.Config.Questions[m.CurrentQuestionIndex]
-			if game.CheckAnswer(m.Input.Value(), currentQ.Answer) {
-				cmds = append(cmds, m.StartTransition())
+		// Input Handling. Enter does nothing during a lockout.
+		keyMsg, isKey := msg.(tea.KeyMsg)
+		if choiceQ := m.currentQuestion(); len(choiceQ.Choices) > 0 {
+			cmds = append(cmds, m.updateChoices(choiceQ, msg))
+		} else if isKey && keyMsg.Type == tea.KeyEnter && !m.lockedOut(time.Now()) {
+			currentQ := m.currentQuestion()
+			if len(currentQ.Parts) > 0 {
+				cmds = append(cmds, m.submitParts(currentQ))
 			} else {
-				m.WrongAnswers++
-				if m.WrongAnswers >= 1 {
-					m.ShowHint = true
-				}
-				m.Input.Reset()
+				cmds = append(cmds, m.submitAnswer(currentQ))
 			}
+		} else if isKey && m.focusPart(keyMsg) {
+			// Tab and Shift+Tab moved between the parts of the question.
 		} else {
 			m.Input, cmd = m.Input.Update(msg)
 			cmds = append(cmds, cmd)
 		}
 
 		// Typewriter logic
-		if _, ok := msg.(game.TickMsg); ok {
-			currentQ := m.Config.Questions[m.CurrentQuestionIndex]
+		if tickMsg, ok := msg.(game.TickMsg); ok {
+			now := time.Time(tickMsg)
+			m.startClocks(now)
+			if timerCmd, ended := m.checkTimers(now); ended {
+				cmds = append(cmds, timerCmd)
+				break
+			}
+			m.updateHints(now, false)
+			currentQ := m.currentQuestion()
 			if m.TypewriterIndex < len(currentQ.Text) {
 				m.TypewriterIndex++
 			}
 		}
 
+	case StateSelect:
+		cmds = append(cmds, m.updateSelect(msg))
+
+	case StateBoard:
+		cmds = append(cmds, m.updateBoard(msg))
+
+	case StatePlayer:
+		cmds = append(cmds, m.updatePlayer(msg))
+
 	case StateSuccess:
 		// Success state logic
 		if m.FinaleTheme != nil {
@@ -549,6 +760,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	cmds = append(cmds, m.syncMouse())
+
+	if m.progressMark() != before {
+		m.saveProgress(time.Now())
+	} else if tickMsg, ok := msg.(game.TickMsg); ok && m.State == StateQuestion && time.Time(tickMsg).Sub(m.lastSave) >= saveInterval {
+		m.saveProgress(time.Time(tickMsg))
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -570,6 +789,12 @@ func (m Model) View() string {
 				if m.ActiveBoot.Done() {
 					action = "[PRESS ENTER TO CONTINUE]"
 				}
+				if m.PendingResume != nil {
+					action = m.resumePrompt()
+				}
+				if prompt := m.levelPrompt(); prompt != "" {
+					action += "\n" + prompt
+				}
 
 				footer := statusStyle.Render(fmt.Sprintf("%s\n%s", m.BootStatus, action))
 				return lipgloss.JoinVertical(lipgloss.Left, bootView, footer)
@@ -584,6 +809,12 @@ func (m Model) View() string {
 			Foreground(lipgloss.Color("#00FF00"))
 
 		classic := "SYSTEM BOOT SEQUENCE INITIATED...\n\n[PRESS ENTER TO HACK THE PLANET]"
+		if m.PendingResume != nil {
+			classic = "SYSTEM BOOT SEQUENCE INITIATED...\n\n" + m.resumePrompt()
+		}
+		if prompt := m.levelPrompt(); prompt != "" {
+			classic += "\n\n" + prompt
+		}
 		if m.BootStatus != "" {
 			classic += "\n\n" + m.BootStatus
 		}
@@ -596,7 +827,7 @@ func (m Model) View() string {
 		return "Loading next level..."
 
 	case StateQuestion:
-		q := m.Config.Questions[m.CurrentQuestionIndex]
+		q := m.currentQuestion()
 
 		visibleText := q.Text
 		if m.TypewriterIndex < len(q.Text) {
@@ -606,23 +837,41 @@ func (m Model) View() string {
 		displayQ := q
 		displayQ.Text = visibleText
 
-		hint := ""
-		if m.ShowHint {
-			hint = q.Hint
+		hint := m.applyHints(&displayQ)
+
+		// The lines below the theme come off the height it may fill.
+		var footer []string
+		if _, ok := m.ActiveTheme.(theme.HUDAware); !ok && !m.Showcase {
+			footer = append(footer, lipgloss.NewStyle().Foreground(lipgloss.Color("#8DF7D9")).Render(" "+m.statusLine()+" "))
+		}
+		if m.Feedback != "" {
+			footer = append(footer, lipgloss.NewStyle().Foreground(lipgloss.Color("#FF8787")).Render(" "+m.Feedback+" "))
+		}
+		if m.hintOnRequest(q) {
+			footer = append(footer, lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD75F")).Render(" [F4] REQUEST HINT "))
+		}
+
+		// Overlay Demo Status
+		if m.AutoDemo {
+			footer = append(footer, lipgloss.NewStyle().Background(lipgloss.Color("#FF0000")).Render(" DEMO MODE "))
 		}
 
 		content := "Error: No Theme Selected"
 		if m.ActiveTheme != nil {
-			if rendered := m.safeThemeView(&displayQ, m.themeInputValue(), hint); rendered != "" {
+			if rendered := m.safeThemeView(&displayQ, m.themeInputs(), hint, m.Height-len(footer)); rendered != "" {
 				content = rendered
 			}
 		}
+		return lipgloss.JoinVertical(lipgloss.Left, append([]string{content}, footer...)...)
 
-		// Overlay Demo Status
-		if m.AutoDemo {
-			content = lipgloss.JoinVertical(lipgloss.Left, content, lipgloss.NewStyle().Background(lipgloss.Color("#FF0000")).Render(" DEMO MODE "))
-		}
-		return content
+	case StateSelect:
+		return m.selectView()
+
+	case StateBoard:
+		return m.boardView()
+
+	case StatePlayer:
+		return m.playerView()
 
 	case StateSuccess:
 		// Draw finale background if available
@@ -630,9 +879,9 @@ func (m Model) View() string {
 		if m.FinaleTheme != nil {
 			// Mock a question so that the Avoidance layout has bounding boxes to avoid
 			mockQ := &game.Question{
-				Text: m.Config.FinalMessage,
+				Text: m.finalMessage(),
 			}
-			bg = m.FinaleTheme.View(m.Width, m.Height, mockQ, m.Config.FinalHint, "")
+			bg = m.FinaleTheme.View(m.Width, m.Height, mockQ, theme.SingleInput(m.finalHint()), m.finalScoreLine())
 		} else {
 			bg = lipgloss.NewStyle().Width(m.Width).Height(m.Height).Render("")
 		}
@@ -651,16 +900,240 @@ func (m Model) View() string {
 			return bg
 		}
 
-		return style.Render(fmt.Sprintf("ACCESS GRANTED\n\n%s\n\n%s", m.Config.FinalMessage, m.Config.FinalHint))
+		title := "ACCESS GRANTED"
+		if m.TimedOut {
+			title = "ACCESS DENIED"
+		}
+		return style.Render(fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s", title, m.finalMessage(), m.finalHint(), m.finalScoreLine()))
 	}
 
 	return ""
 }
 
-func (m *Model) safeThemeView(q *game.Question, inputView, hint string) (view string) {
+// currentQuestion returns the active question with {{player}} filled in, or
+// a placeholder text while a chained pack still keeps it encrypted.
+func (m *Model) currentQuestion() game.Question {
+	q := m.Config.Questions[m.CurrentQuestionIndex]
+	if q.Locked() {
+		q.Text = lockedQuestionText
+		return q
+	}
+	q.Text = m.personalize(q.Text)
+	ladder := q.HintLadder()
+	q.Hint, q.Hints = "", make([]game.Hint, len(ladder))
+	for i, h := range ladder {
+		h.Text = m.personalize(h.Text)
+		q.Hints[i] = h
+	}
+	return q
+}
+
+func (m *Model) finalMessage() string {
+	if m.TimedOut {
+		return m.Config.TimeUpText()
+	}
+	if m.Config.FinaleLocked() {
+		return lockedQuestionText
+	}
+	message := m.personalize(m.Config.FinalMessage)
+	flag, err := m.Config.PlayerFlag(m.PlayerID())
+	if err != nil || flag == "" {
+		return message
+	}
+	if !m.solvedAll() {
+		flag = noFlagText
+	}
+	if strings.Contains(message, "{{flag}}") {
+		return strings.ReplaceAll(message, "{{flag}}", flag)
+	}
+	return strings.TrimSpace(message + "\n\n" + flag)
+}
+
+// solvedAll reports whether the player solved every required question they
+// came across, rather than reaching the end by skipping some on a timeout.
+func (m *Model) solvedAll() bool {
+	if !m.Config.Finished(m.Trail) {
+		return false
+	}
+	for _, q := range m.Config.Questions {
+		if m.Trail.IsDone(q.ID) && !q.Optional && !m.Score.Solved(q.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Model) finalHint() string {
+	if m.TimedOut {
+		return ""
+	}
+	return m.personalize(m.Config.FinalHint)
+}
+
+// unlockNext decrypts the next question of a chained pack with the accepted
+// input. Failures are kept for the debug snapshot; the next question then
+// shows a locked placeholder instead of blocking progress.
+func (m *Model) unlockNext(input string) {
+	m.ChainError = ""
+	if err := m.Config.Unlock(m.CurrentQuestionIndex, input); err != nil {
+		m.ChainError = err.Error()
+	}
+}
+
+// applyHints replaces the hints of a display copy of the question with the
+// revealed part of its hint ladder and returns them joined into one line.
+func (m *Model) applyHints(q *game.Question) string {
+	ladder := q.HintLadder()
+	revealed := m.HintsRevealed
+	if m.ShowHint || revealed > len(ladder) {
+		revealed = len(ladder)
+	}
+
+	q.Hint = ""
+	q.Hints = ladder[:revealed]
+	texts := make([]string, 0, revealed)
+	for _, h := range q.Hints {
+		texts = append(texts, h.Text)
+	}
+	return strings.Join(texts, hintSeparator)
+}
+
+// updateHints reveals the hints whose unlock rules are met at now. requested
+// is set when the player pressed the hint key.
+func (m *Model) updateHints(now time.Time, requested bool) {
+	if m.QuestionStart.IsZero() {
+		m.QuestionStart = now
+	}
+	ladder := m.currentQuestion().HintLadder()
+	m.HintsRevealed = game.RevealHints(ladder, m.HintsRevealed, m.WrongAnswers, now.Sub(m.QuestionStart), requested)
+}
+
+// questionElapsed returns the time spent on the current question so far.
+func (m *Model) questionElapsed(now time.Time) time.Duration {
+	if m.QuestionStart.IsZero() {
+		return m.questionOffset
+	}
+	return now.Sub(m.QuestionStart)
+}
+
+func (m *Model) gameElapsed(now time.Time) time.Duration {
+	if m.GameStart.IsZero() {
+		return m.gameOffset
+	}
+	return now.Sub(m.GameStart)
+}
+
+func (m *Model) startClocks(now time.Time) {
+	if m.GameStart.IsZero() {
+		m.GameStart = now.Add(-m.gameOffset)
+		m.gameOffset = 0
+	}
+	if m.QuestionStart.IsZero() {
+		m.QuestionStart = now.Add(-m.questionOffset)
+		m.questionOffset = 0
+	}
+	m.LastTick = now
+}
+
+// checkTimers applies the game deadline and the current question's time
+// limit at now. ended is true when the question is over (skipped or the game
+// finished); cmd then starts the transition or finale.
+func (m *Model) checkTimers(now time.Time) (cmd tea.Cmd, ended bool) {
+	if m.Showcase {
+		return nil, false
+	}
+	if m.deadlinePassed(now) {
+		m.TimedOut = true
+		return m.startFinale(), true
+	}
+
+	q := m.currentQuestion()
+	limit := q.QuestionLimit()
+	if limit == 0 || m.QuestionExpired || m.questionElapsed(now) < limit {
+		return nil, false
+	}
+	m.QuestionExpired = true
+
+	switch m.Config.ExpireAction(q) {
+	case game.ExpireSkip:
+		m.recordInput("")
+		m.Trail.Finish(q, game.Answer{})
+		return m.StartTransition(), true
+	case game.ExpireEnd:
+		m.TimedOut = true
+		return m.startFinale(), true
+	default:
+		m.HintsRevealed = len(q.HintLadder())
+		return nil, false
+	}
+}
+
+func (m *Model) deadlinePassed(now time.Time) bool {
+	if m.GameStart.IsZero() {
+		return false
+	}
+	deadline, ok := m.Config.GameDeadline(m.GameStart)
+	return ok && !now.Before(deadline)
+}
+
+func (m *Model) hud() theme.HUD {
+	h := theme.HUD{
+		Score:  m.Score.Total(),
+		Solved: len(m.Score.Results),
+		Total:  len(m.Config.Questions),
+		Player: m.Player,
+	}
+	if m.CurrentQuestionIndex < len(m.Config.Questions) {
+		h.LevelCode = m.Config.Questions[m.CurrentQuestionIndex].Level
+	}
+
+	now := m.LastTick
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if m.CurrentQuestionIndex < len(m.Config.Questions) {
+		if limit := m.Config.Questions[m.CurrentQuestionIndex].QuestionLimit(); limit > 0 && !m.QuestionExpired {
+			h.QuestionTimed = true
+			h.QuestionLeft = limit - m.questionElapsed(now)
+		}
+	}
+	if !m.GameStart.IsZero() {
+		if deadline, ok := m.Config.GameDeadline(m.GameStart); ok {
+			h.GameTimed = true
+			h.GameLeft = deadline.Sub(now)
+		}
+	}
+	h.LockedLeft = m.Lockout.Remaining(now)
+	return h
+}
+
+// statusLine is the HUD for themes that don't draw it themselves.
+func (m *Model) statusLine() string {
+	return m.hud().String()
+}
+
+func (m *Model) finalScoreLine() string {
+	if !m.solvedAll() {
+		return fmt.Sprintf("%d/%d solved", len(m.Score.Results), len(m.Config.Questions))
+	}
+	return fmt.Sprintf("FINAL SCORE %d  (%d/%d solved in %s)", m.Score.Total(), len(m.Score.Results), len(m.Config.Questions), m.Score.Elapsed().Round(time.Second))
+}
+
+// hintOnRequest reports whether the next hidden hint can be requested.
+func (m *Model) hintOnRequest(q game.Question) bool {
+	ladder := q.HintLadder()
+	return !m.ShowHint && m.HintsRevealed < len(ladder) && ladder[m.HintsRevealed].OnRequest
+}
+
+// safeThemeView renders the active theme into height rows; rows it draws
+// beyond that are cut so the lines below it stay on screen.
+func (m *Model) safeThemeView(q *game.Question, inputs []theme.Input, hint string, height int) (view string) {
 	if m.ActiveTheme == nil || m.Width <= 0 || m.Height <= 0 {
 		return ""
 	}
+	if height < 3 {
+		height = m.Height
+	}
 
 	defer func() {
 		if recover() != nil {
@@ -668,7 +1141,14 @@ func (m *Model) safeThemeView(q *game.Question, inputView, hint string) (view st
 		}
 	}()
 
-	return m.ActiveTheme.View(m.Width, m.Height, q, inputView, hint)
+	if hudAware, ok := m.ActiveTheme.(theme.HUDAware); ok {
+		hudAware.SetHUD(m.hud())
+	}
+	view = m.ActiveTheme.View(m.Width, height, q, inputs, hint)
+	if lines := strings.Split(view, "\n"); len(lines) > height {
+		view = strings.Join(lines[:height], "\n")
+	}
+	return view
 }
 
 func (m *Model) safeBootView() (view string) {
@@ -705,7 +1185,12 @@ func (m *Model) safeTransitionView() (view string) {
 }
 
 func (m *Model) themeInputValue() string {
-	plain := ansi.Strip(m.Input.Value())
+	return plainInput(m.Input.Value())
+}
+
+// plainInput makes typed text safe to draw inside a theme.
+func plainInput(s string) string {
+	plain := ansi.Strip(s)
 	plain = sgrTextPattern.ReplaceAllString(plain, "")
 	plain = strings.ReplaceAll(plain, "\n", " ")
 	plain = strings.ReplaceAll(plain, "\r", " ")
@@ -750,7 +1235,7 @@ func (m Model) DebugSnapshot() string {
 	qID := -1
 	qText := ""
 	if m.Config != nil && m.CurrentQuestionIndex >= 0 && m.CurrentQuestionIndex < len(m.Config.Questions) {
-		q := m.Config.Questions[m.CurrentQuestionIndex]
+		q := m.currentQuestion()
 		qID = q.ID
 		qText = trimForDebug(q.Text, 96)
 	}
@@ -771,8 +1256,35 @@ func (m Model) DebugSnapshot() string {
 	b.WriteString(fmt.Sprintf("boot: name=%q done=%t status=%q\n", activeBootName, activeBootDone, m.BootStatus))
 	b.WriteString(fmt.Sprintf("theme: name=%q type=%s\n", activeThemeName, typeName(m.ActiveTheme)))
 	b.WriteString(fmt.Sprintf("transition: type=%s ticks=%d watchdog_hit=%t watchdog_limit=%d\n", typeName(m.ActiveTransition), m.TransitionTickCount, m.TransitionWatchdogHit, transitionWatchdogTicks))
-	b.WriteString(fmt.Sprintf("progress: question_index=%d question_id=%d wrong_answers=%d hint_visible=%t typewriter_index=%d\n", m.CurrentQuestionIndex, qID, m.WrongAnswers, m.ShowHint, m.TypewriterIndex))
+	b.WriteString(fmt.Sprintf("progress: question_index=%d question_id=%d wrong_answers=%d hint_visible=%t hints_revealed=%d typewriter_index=%d\n", m.CurrentQuestionIndex, qID, m.WrongAnswers, m.ShowHint, m.HintsRevealed, m.TypewriterIndex))
 	b.WriteString(fmt.Sprintf("question_preview: %q\n", qText))
+	b.WriteString(fmt.Sprintf("timers: question_expired=%t timed_out=%t\n", m.QuestionExpired, m.TimedOut))
+	b.WriteString(fmt.Sprintf("lockout: strikes=%d count=%d remaining=%s\n", m.Lockout.Strikes, m.Lockout.Count, m.Lockout.Remaining(time.Now()).Round(time.Millisecond)))
+	b.WriteString(fmt.Sprintf("save: path=%q pending_resume=%t error=%q\n", m.SavePath, m.PendingResume != nil, trimForDebug(m.SaveError, 96)))
+	b.WriteString(fmt.Sprintf("seed: %d randomized=%t\n", m.Seed, m.source != nil && m.source.Randomized()))
+	b.WriteString(fmt.Sprintf("player: name=%q id=%q\n", trimForDebug(m.Player, 64), trimForDebug(m.PlayerID(), 64)))
+	b.WriteString(fmt.Sprintf("score: total=%d solved=%d elapsed=%s\n", m.Score.Total(), len(m.Score.Results), m.Score.Elapsed().Round(time.Millisecond)))
+	if label := m.LastMatch.Label(); label != "" {
+		policy := m.LastMatch.Match
+		if policy == "" {
+			policy = game.MatchFuzzy
+		}
+		b.WriteString(fmt.Sprintf("last_match: question_id=%d alias=%q policy=%s\n", m.LastMatchQuestionID, trimForDebug(label, 64), policy))
+	}
+	if m.State == StateQuestion {
+		if q := m.currentQuestion(); len(q.Choices) > 0 {
+			b.WriteString(fmt.Sprintf("choice: %d/%d\n", m.ChoiceIndex+1, len(q.Choices)))
+		}
+	}
+	if len(m.PartSolved) > 0 {
+		b.WriteString(fmt.Sprintf("parts: focus=%d solved=%v\n", m.PartFocus, m.PartSolved))
+	}
+	if m.Feedback != "" {
+		b.WriteString(fmt.Sprintf("feedback: %q penalties=%d\n", trimForDebug(m.Feedback, 96), len(m.Score.Penalties)))
+	}
+	if m.ChainError != "" {
+		b.WriteString(fmt.Sprintf("chain_error: %q\n", trimForDebug(m.ChainError, 96)))
+	}
 	b.WriteString(fmt.Sprintf("input: len=%d value=%q\n", len([]rune(inputPreview)), trimForDebug(inputPreview, 96)))
 	b.WriteString(fmt.Sprintf("modes: showcase=%t auto_demo=%t\n", m.Showcase, m.AutoDemo))
 	b.WriteString("=== END SNAPSHOT ===")
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"ctf-tool/pkg/ui/theme"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ensureParts sizes the part state for q, starting over if it belongs to a
+// different question.
+func (m *Model) ensureParts(q game.Question) {
+	if len(m.PartValues) == len(q.Parts) && len(m.PartSolved) == len(q.Parts) && len(m.partMatches) == len(q.Parts) {
+		return
+	}
+	m.PartValues = make([]string, len(q.Parts))
+	m.PartSolved = make([]bool, len(q.Parts))
+	m.partMatches = make([]game.Answer, len(q.Parts))
+	m.PartFocus = 0
+}
+
+// themeInputs lists the answer fields of the current question for the theme.
+func (m *Model) themeInputs() []theme.Input {
+	q := m.currentQuestion()
+	if len(q.Choices) > 0 {
+		return theme.ChoiceInputs(q.Choices, m.ChoiceIndex)
+	}
+	if len(q.Parts) == 0 {
+		return theme.SingleInput(m.themeInputValue())
+	}
+	inputs := make([]theme.Input, len(q.Parts))
+	for i, p := range q.Parts {
+		in := theme.Input{Label: p.Name}
+		if i < len(m.PartValues) {
+			in.Value = plainInput(m.PartValues[i])
+			in.Solved = m.PartSolved[i]
+		}
+		if i == m.PartFocus {
+			in.Value, in.Focused = m.themeInputValue(), true
+		}
+		inputs[i] = in
+	}
+	return inputs
+}
+
+// focusPart moves the focus to the next (Tab) or previous (Shift+Tab)
+// unsolved part. It reports whether msg was one of those keys.
+func (m *Model) focusPart(msg tea.KeyMsg) bool {
+	step := 0
+	switch msg.Type {
+	case tea.KeyTab:
+		step = 1
+	case tea.KeyShiftTab:
+		step = -1
+	default:
+		return false
+	}
+	q := m.currentQuestion()
+	if len(q.Parts) == 0 {
+		return false
+	}
+	m.ensureParts(q)
+	m.PartValues[m.PartFocus] = m.Input.Value()
+	for i := 1; i < len(q.Parts); i++ {
+		next := (m.PartFocus + step*i + len(q.Parts)) % len(q.Parts)
+		if !m.PartSolved[next] {
+			m.setPartFocus(next)
+			break
+		}
+	}
+	return true
+}
+
+func (m *Model) setPartFocus(i int) {
+	m.PartFocus = i
+	m.Input.SetValue(m.PartValues[i])
+	m.Input.CursorEnd()
+}
+
+// submitParts checks every filled-in unsolved part of q. Solved parts stay
+// solved; the question is done once all are. Wrong parts are cleared and
+// count as one wrong answer together, empty ones don't count.
+func (m *Model) submitParts(q game.Question) tea.Cmd {
+	m.ensureParts(q)
+	m.PartValues[m.PartFocus] = m.Input.Value()
+
+	wrong := -1
+	var wrongGuess *game.Guess
+	solved := 0
+	for i := range q.Parts {
+		if !m.PartSolved[i] && strings.TrimSpace(m.PartValues[i]) != "" {
+			guess := game.NewGuess(m.PartValues[i], q.PartQuestion(i))
+			if match, ok := guess.Answer(); ok {
+				m.PartSolved[i], m.partMatches[i] = true, match
+			} else if wrong < 0 {
+				wrong, wrongGuess = i, guess
+			}
+		}
+		if m.PartSolved[i] {
+			solved++
+		}
+	}
+
+	if solved == len(q.Parts) {
+		for _, match := range m.partMatches {
+			m.Trail.Finish(q, match)
+		}
+		last := m.partMatches[len(m.partMatches)-1]
+		return m.solve(q, last, strings.Join(m.PartValues, "\n"))
+	}
+
+	progress := fmt.Sprintf("%d/%d PARTS CORRECT", solved, len(q.Parts))
+	if wrong >= 0 {
+		m.WrongAnswers++
+		m.respond(q.PartQuestion(wrong), wrongGuess)
+		if m.Feedback != "" {
+			m.Feedback = strings.ToUpper(q.Parts[wrong].Name) + ": " + m.Feedback
+		}
+		m.strike(time.Now())
+		m.updateHints(time.Now(), false)
+		for i := range q.Parts {
+			if !m.PartSolved[i] && strings.TrimSpace(m.PartValues[i]) != "" {
+				m.PartValues[i] = ""
+			}
+		}
+	} else {
+		m.Feedback = ""
+	}
+	if m.Feedback == "" {
+		m.Feedback = progress
+	} else {
+		m.Feedback = progress + "  " + m.Feedback
+	}
+
+	// Continue with the first unsolved part.
+	for i := range q.Parts {
+		if !m.PartSolved[i] {
+			m.setPartFocus(i)
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMultiPartQuestionTabAndPartialProgress(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{{ID: 1, Text: "Q1", Parts: []game.Part{
+			{Name: "user", Answers: []game.Answer{{Text: "admin"}}},
+			{Name: "port", Answers: []game.Answer{{Text: "22"}}, Check: game.CheckExact},
+		}}},
+		FinalMessage: "EGG",
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	press := func(msg tea.KeyMsg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	m.Input.SetValue("admin")
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	m.Input.SetValue("23")
+	if inputs := m.themeInputs(); len(inputs) != 2 || inputs[0].Value != "admin" || !inputs[1].Focused {
+		t.Fatalf("expected two fields with the port focused, got %+v", inputs)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.WrongAnswers != 1 || !strings.HasPrefix(m.Feedback, "1/2 PARTS CORRECT") {
+		t.Fatalf("expected partial progress, state=%s wrong=%d feedback=%q", gameStateName(m.State), m.WrongAnswers, m.Feedback)
+	}
+	if m.PartFocus != 1 || m.Input.Value() != "" {
+		t.Fatalf("the wrong part should be cleared and focused, focus=%d input=%q", m.PartFocus, m.Input.Value())
+	}
+
+	// A solved part is skipped by Tab and survives a restart.
+	press(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if m.PartFocus != 1 {
+		t.Fatalf("Shift+Tab should skip the solved part, focus=%d", m.PartFocus)
+	}
+	resumed := NewModel(cfg)
+	resumed.resume(m.Progress(time.Now()))
+	if len(resumed.PartSolved) != 2 || !resumed.PartSolved[0] || resumed.PartFocus != 1 {
+		t.Fatalf("solved part not restored: %+v focus=%d", resumed.PartSolved, resumed.PartFocus)
+	}
+
+	m.Input.SetValue("22")
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State == StateQuestion || !m.Trail.IsDone(1) {
+		t.Fatalf("expected the question to be solved, state=%s", gameStateName(m.State))
+	}
+}
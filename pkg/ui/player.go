@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"ctf-tool/pkg/ui/boot"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// anonymousName fills {{player}} until the player gives a name.
+const anonymousName = "player"
+
+// SetPlayer sets the player's name or team. It greets them in the boot
+// intro, fills {{player}} in the pack, and keys their flag and, unless
+// SetSeed fixed it, their draw of questions.
+func (m *Model) SetPlayer(name string) {
+	m.Player = name
+	m.reseed()
+	if aware, ok := m.ActiveBoot.(boot.PlayerAware); ok {
+		aware.SetPlayer(name)
+	}
+}
+
+// SetSession identifies an anonymous player by their session token, so a
+// web player keeps their flag and draw across reconnects.
+func (m *Model) SetSession(token string) {
+	m.anonID = token
+	m.reseed()
+}
+
+// PlayerID is what the player's flag and seed are derived from: their name,
+// or the anonymous id.
+func (m *Model) PlayerID() string {
+	if m.Player != "" {
+		return m.Player
+	}
+	return m.anonID
+}
+
+func (m *Model) reseed() {
+	if !m.seedFixed {
+		m.draw(game.SessionSeed(m.PackID, m.PlayerID()))
+	}
+}
+
+// personalize fills {{player}} in pack text.
+func (m *Model) personalize(text string) string {
+	name := m.Player
+	if name == "" {
+		name = anonymousName
+	}
+	return game.FillPlayer(text, name)
+}
+
+func (m *Model) needsPlayer() bool {
+	return m.Config.PlayerPrompt != "" && !m.playerAsked
+}
+
+// askPlayer shows the name prompt, pre-filled with a name given on the
+// command line or by the web page.
+func (m *Model) askPlayer() {
+	m.State = StatePlayer
+	m.playerAsked = true
+	m.Input.Reset()
+	m.Input.Placeholder = "Name or team..."
+	m.Input.CharLimit = game.MaxPlayerName
+	m.Input.SetValue(m.Player)
+	m.Input.CursorEnd()
+}
+
+// updatePlayer handles the name prompt. An empty name keeps the player
+// anonymous.
+func (m *Model) updatePlayer(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case game.TickMsg:
+		// No theme is active here to keep the tick loop alive.
+		return tick()
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyEnter {
+			if name := game.CleanPlayerName(m.Input.Value()); name != "" {
+				m.SetPlayer(name)
+			}
+			m.Input.Reset()
+			m.Input.Placeholder = answerPlaceholder
+			m.Input.CharLimit = answerCharLimit
+			m.beginQuestions()
+			return nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.Input, cmd = m.Input.Update(msg)
+	return cmd
+}
+
+func (m *Model) playerView() string {
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(m.Config.PlayerPrompt))
+	b.WriteString("\n\n")
+	b.WriteString(m.Input.View())
+	b.WriteString("\n\n[ENTER] CONTINUE")
+
+	return lipgloss.NewStyle().
+		Width(m.Width).
+		Height(m.Height).
+		Align(lipgloss.Center, lipgloss.Center).
+		Foreground(lipgloss.Color("#00FF00")).
+		Render(b.String())
+}
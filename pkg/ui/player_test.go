@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPlayerPromptPersonalizesGame(t *testing.T) {
+	cfg := &game.Config{
+		PlayerPrompt: "Enter your team name",
+		Questions:    []game.Question{{ID: 1, Text: "Hello {{player}}, what is 1+1?", Answer: "2", Hint: "Think, {{player}}."}},
+		FinalMessage: "Well done, {{player}}.",
+	}
+	m := NewModel(cfg)
+	m.ActiveBoot = nil
+	m.Width, m.Height = 80, 24
+	m.SetPlayer("From URL")
+
+	send := func(msg tea.Msg) {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StatePlayer || m.Input.Value() != "From URL" {
+		t.Fatalf("expected a pre-filled name prompt, state=%s input=%q", gameStateName(m.State), m.Input.Value())
+	}
+	if view := m.View(); !strings.Contains(view, "ENTER YOUR TEAM NAME") {
+		t.Fatalf("prompt should show the pack's title:\n%s", view)
+	}
+
+	m.Input.SetValue("  Blue\tTeam ")
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.State != StateQuestion || m.Player != "Blue Team" || m.PlayerID() != "Blue Team" {
+		t.Fatalf("expected the cleaned name and the first question, state=%s player=%q", gameStateName(m.State), m.Player)
+	}
+	if m.Input.Placeholder != answerPlaceholder {
+		t.Fatalf("answer input should be restored, placeholder %q", m.Input.Placeholder)
+	}
+
+	q := m.currentQuestion()
+	if q.Text != "Hello Blue Team, what is 1+1?" || q.HintLadder()[0].Text != "Think, Blue Team." {
+		t.Fatalf("question not personalized: %q / %+v", q.Text, q.HintLadder())
+	}
+	if got := m.hud().String(); !strings.Contains(got, "PLAYER Blue Team") {
+		t.Fatalf("HUD should name the player: %q", got)
+	}
+	if got := m.finalMessage(); got != "Well done, Blue Team." {
+		t.Fatalf("finalMessage = %q", got)
+	}
+	if m.Seed != game.SessionSeed(m.PackID, "Blue Team") {
+		t.Fatalf("the seed should follow the player")
+	}
+}
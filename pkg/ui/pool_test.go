@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRandomizedPackKeepsSeedAcrossResume(t *testing.T) {
+	cfg := &game.Config{
+		Pools:   []game.Pool{{Name: "p", Pick: 2}},
+		Shuffle: true,
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "A1", Pool: "p"},
+			{ID: 2, Text: "Q2", Answer: "A2", Pool: "p"},
+			{ID: 3, Text: "Q3", Answer: "A3", Pool: "p"},
+			{ID: 4, Text: "Q4", Answer: "A4", Pool: "p"},
+		},
+		FinalMessage: "EGG",
+	}
+	ids := func(m Model) []int {
+		var out []int
+		for _, q := range m.Config.Questions {
+			out = append(out, q.ID)
+		}
+		return out
+	}
+	path := filepath.Join(t.TempDir(), "progress.json")
+
+	m := NewModel(cfg)
+	m.SetSeed(42)
+	m.EnableSaving(path)
+	drawn := ids(m)
+	if len(drawn) != 2 {
+		t.Fatalf("expected two drawn questions, got %v", drawn)
+	}
+	if !strings.Contains(m.DebugSnapshot(), "seed: 42 randomized=true") {
+		t.Fatalf("debug snapshot should show the seed:\n%s", m.DebugSnapshot())
+	}
+
+	m.State = StateQuestion
+	m.Input.SetValue(m.Config.Questions[0].Answer)
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if m.SaveError != "" {
+		t.Fatalf("save failed: %s", m.SaveError)
+	}
+
+	resumed := NewModel(cfg)
+	resumed.SetSeed(7)
+	resumed.EnableSaving(path)
+	next, _ = resumed.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	resumed = next.(Model)
+	if resumed.Seed != 42 || fmt.Sprint(ids(resumed)) != fmt.Sprint(drawn) {
+		t.Fatalf("expected the saved draw %v with seed 42, got %v with seed %d", drawn, ids(resumed), resumed.Seed)
+	}
+	if resumed.CurrentQuestionIndex != 1 {
+		t.Fatalf("expected to resume at the second question, got %d", resumed.CurrentQuestionIndex)
+	}
+}
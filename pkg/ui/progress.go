@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// EnableSaving persists progress to path and, if a saved game for this pack
+// exists there, offers to resume it on the intro screen.
+func (m *Model) EnableSaving(path string) {
+	m.SavePath = path
+
+	p, err := game.LoadProgress(path, m.PackID)
+	switch {
+	case err == nil:
+		m.PendingResume = &p
+	case errors.Is(err, fs.ErrNotExist):
+	case errors.Is(err, game.ErrTamperedProgress):
+		m.BootStatus = "Saved progress was modified and has been discarded."
+		os.Remove(path)
+	default:
+		m.SaveError = err.Error()
+	}
+}
+
+// progressMark is the part of the model whose change triggers a save.
+type progressMark struct {
+	state    GameState
+	index    int
+	wrong    int
+	hints    int
+	parts    int
+	timedOut bool
+}
+
+func (m *Model) progressMark() progressMark {
+	parts := 0
+	for _, solved := range m.PartSolved {
+		if solved {
+			parts++
+		}
+	}
+	return progressMark{m.State, m.CurrentQuestionIndex, m.WrongAnswers, m.HintsRevealed, parts, m.TimedOut}
+}
+
+// Progress returns the current game as a saved state.
+func (m *Model) Progress(now time.Time) game.Progress {
+	return game.Progress{
+		Pack:            m.PackID,
+		QuestionIndex:   m.CurrentQuestionIndex,
+		WrongAnswers:    m.WrongAnswers,
+		HintsRevealed:   m.HintsRevealed,
+		QuestionElapsed: m.questionElapsed(now),
+		GameElapsed:     m.gameElapsed(now),
+		Results:         m.Score.Results,
+		Penalties:       m.Score.Penalties,
+		Inputs:          m.inputs,
+		Level:           m.levelCode,
+		Parts:           m.solvedParts(),
+		Trail:           m.Trail,
+		Lockout:         m.Lockout,
+		Seed:            m.Seed,
+		Player:          m.Player,
+		AnonID:          m.anonID,
+		Finished:        m.State == StateSuccess,
+		TimedOut:        m.TimedOut,
+		SavedAt:         now,
+	}
+}
+
+func (m *Model) saveProgress(now time.Time) {
+	if m.SavePath == "" || m.Showcase || m.PendingResume != nil || m.State == StateIntro {
+		return
+	}
+	m.lastSave = now
+	m.SaveError = ""
+	if err := game.SaveProgress(m.SavePath, m.Progress(now)); err != nil {
+		m.SaveError = err.Error()
+	}
+}
+
+// discardProgress drops the offered saved game and deletes its file. A
+// running lockout is kept, so starting over doesn't end it.
+func (m *Model) discardProgress() {
+	if m.PendingResume.Lockout.Remaining(time.Now()) > 0 {
+		m.Lockout.Merge(game.Lockout{Count: m.PendingResume.Lockout.Count, Until: m.PendingResume.Lockout.Until})
+	}
+	m.PendingResume = nil
+	if m.SavePath != "" {
+		os.Remove(m.SavePath)
+	}
+	m.BootStatus = "Saved progress discarded. Starting over."
+}
+
+// resume restores a saved game. Chained questions are unlocked again with
+// the saved inputs; if that fails the game starts over.
+func (m *Model) resume(p game.Progress) tea.Cmd {
+	m.PendingResume = nil
+	if p.Seed != 0 {
+		m.SetSeed(p.Seed)
+	}
+	// Keep the player the flag of this game is rendered for.
+	if m.Player == "" {
+		m.Player = p.Player
+	}
+	if p.AnonID != "" {
+		m.anonID = p.AnonID
+	}
+	// A lockout imposed by the web server stays in force.
+	m.Lockout.Merge(p.Lockout)
+	if err := m.replayInputs(p); err != nil {
+		m.ChainError = err.Error()
+		m.BootStatus = "Saved progress could not be restored. Starting over."
+		m.State = StateQuestion
+		return nil
+	}
+
+	m.CurrentQuestionIndex = p.QuestionIndex
+	m.WrongAnswers = p.WrongAnswers
+	m.HintsRevealed = p.HintsRevealed
+	m.Score.Results = append([]game.QuestionResult(nil), p.Results...)
+	m.Score.Penalties = append([]game.Penalty(nil), p.Penalties...)
+	m.inputs = append([]string(nil), p.Inputs...)
+	m.levelCode = p.Level
+	m.Trail = game.Trail{
+		Done:   append([]int(nil), p.Trail.Done...),
+		Opened: append([]int(nil), p.Trail.Opened...),
+	}
+	m.questionOffset = p.QuestionElapsed
+	m.gameOffset = p.GameElapsed
+	m.TimedOut = p.TimedOut
+	m.TypewriterIndex = 0
+
+	if p.Finished || p.QuestionIndex >= len(m.Config.Questions) {
+		return m.startFinale()
+	}
+	if !m.Config.Linear() && m.Trail.IsDone(m.Config.Questions[p.QuestionIndex].ID) {
+		// Saved between questions of a graph: pick up where the player
+		// was choosing.
+		next, choose := m.nextQuestion()
+		switch {
+		case choose:
+			m.openSelect()
+			return nil
+		case next < 0:
+			return m.startFinale()
+		}
+		m.CurrentQuestionIndex = next
+		m.resetQuestionState()
+	}
+	m.restoreParts(p.Parts)
+	m.State = StateQuestion
+	return nil
+}
+
+// solvedParts returns the inputs of the solved parts of the current
+// question, or nil if none is solved.
+func (m *Model) solvedParts() []string {
+	var parts []string
+	for i, solved := range m.PartSolved {
+		if solved {
+			if parts == nil {
+				parts = make([]string, len(m.PartSolved))
+			}
+			parts[i] = m.PartValues[i]
+		}
+	}
+	return parts
+}
+
+// restoreParts solves the parts of the current question again with the
+// saved inputs. Inputs that no longer match are dropped.
+func (m *Model) restoreParts(saved []string) {
+	q := m.currentQuestion()
+	if len(saved) != len(q.Parts) || len(q.Parts) == 0 {
+		return
+	}
+	m.ensureParts(q)
+	for i, input := range saved {
+		if input == "" {
+			continue
+		}
+		if match, ok := game.MatchPart(input, q, i); ok {
+			m.PartValues[i], m.PartSolved[i], m.partMatches[i] = input, true, match
+		}
+	}
+	for i := range q.Parts {
+		if !m.PartSolved[i] {
+			m.setPartFocus(i)
+			break
+		}
+	}
+}
+
+func (m *Model) replayInputs(p game.Progress) error {
+	if p.QuestionIndex < 0 || p.QuestionIndex > len(m.Config.Questions) || len(p.Inputs) > p.QuestionIndex {
+		return fmt.Errorf("saved question index %d out of range", p.QuestionIndex)
+	}
+	if p.Level != "" {
+		// A game started from a level code skipped the questions before
+		// it, so only the code opens its first question.
+		if _, err := m.Config.JumpToLevel(p.Level); err != nil {
+			return fmt.Errorf("level code: %w", err)
+		}
+	}
+	for i, input := range p.Inputs {
+		if input == "" {
+			continue
+		}
+		if err := m.Config.Unlock(i, input); err != nil {
+			return fmt.Errorf("question %d: %w", m.Config.Questions[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// recordInput remembers the input that finished the current question, for
+// replaying chained unlocks on resume. It is only kept when the question
+// actually unlocks something.
+func (m *Model) recordInput(input string) {
+	if !m.Config.Linear() {
+		// Only linear packs are chained.
+		return
+	}
+	if len(m.Config.Questions[m.CurrentQuestionIndex].Unlocks) == 0 {
+		input = ""
+	}
+	for len(m.inputs) < m.CurrentQuestionIndex {
+		m.inputs = append(m.inputs, "")
+	}
+	m.inputs = append(m.inputs[:m.CurrentQuestionIndex:m.CurrentQuestionIndex], input)
+}
+
+// resumePrompt describes the offered saved game for the intro screen.
+func (m *Model) resumePrompt() string {
+	p := m.PendingResume
+	where := fmt.Sprintf("question %d/%d", p.QuestionIndex+1, len(m.Config.Questions))
+	if p.Finished || p.QuestionIndex >= len(m.Config.Questions) {
+		where = "finished"
+	}
+	score := game.Scorecard{Results: p.Results, Penalties: p.Penalties}.Total()
+	return fmt.Sprintf("SAVED GAME FOUND (%s, score %d)\n[ENTER] RESUME   [N] START OVER", where, score)
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestProgressSavedAndResumed(t *testing.T) {
+	newConfig := func() *game.Config {
+		return &game.Config{
+			Questions: []game.Question{
+				{ID: 1, Text: "Q1", Answer: "A1"},
+				{ID: 2, Text: "Q2", Answer: "A2"},
+				{ID: 3, Text: "Q3", Answer: "A3"},
+			},
+			FinalMessage: "EGG",
+		}
+	}
+	sealed := newConfig()
+	if _, err := sealed.SealChain(); err != nil {
+		t.Fatalf("SealChain: %v", err)
+	}
+	raw, err := json.Marshal(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	load := func() *game.Config {
+		cfg, err := game.ParseConfig(raw)
+		if err != nil {
+			t.Fatalf("ParseConfig: %v", err)
+		}
+		return cfg
+	}
+	path := filepath.Join(t.TempDir(), "progress.json")
+
+	m := NewModel(load())
+	m.EnableSaving(path)
+	if m.PendingResume != nil {
+		t.Fatalf("expected no saved game yet")
+	}
+	m.State = StateQuestion
+	m.Input.SetValue("A1")
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	m.State = StateQuestion // skip the transition animation
+	m.Input.SetValue("nope")
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = next.(Model)
+	if m.SaveError != "" {
+		t.Fatalf("save failed: %s", m.SaveError)
+	}
+
+	resumed := NewModel(load())
+	resumed.EnableSaving(path)
+	if resumed.PendingResume == nil {
+		t.Fatalf("expected a saved game to be offered")
+	}
+	next, _ = resumed.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	resumed = next.(Model)
+
+	if resumed.State != StateQuestion || resumed.CurrentQuestionIndex != 1 || resumed.WrongAnswers != 1 {
+		t.Fatalf("unexpected resumed state=%s index=%d wrong=%d", gameStateName(resumed.State), resumed.CurrentQuestionIndex, resumed.WrongAnswers)
+	}
+	if got := resumed.currentQuestion(); got.Text != "Q2" {
+		t.Fatalf("expected chained question to be unlocked on resume, got %q (chain error %q)", got.Text, resumed.ChainError)
+	}
+	if len(resumed.Score.Results) != 1 {
+		t.Fatalf("expected the score to be restored, got %+v", resumed.Score.Results)
+	}
+
+	// Answering the resumed question unlocks the next one as usual.
+	resumed.Input.SetValue("A2")
+	next, _ = resumed.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	resumed = next.(Model)
+	if got := resumed.currentQuestion(); got.Text != "Q3" {
+		t.Fatalf("expected Q3 after resuming, got %q", got.Text)
+	}
+}
+
+func TestStartOverDiscardsSavedGame(t *testing.T) {
+	cfg := &game.Config{Questions: []game.Question{{ID: 1, Text: "Q1", Answer: "A1"}}, FinalMessage: "EGG"}
+	path := filepath.Join(t.TempDir(), "progress.json")
+	if err := game.SaveProgress(path, game.Progress{Pack: cfg.Fingerprint(), Finished: true, QuestionIndex: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(cfg)
+	m.EnableSaving(path)
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m = next.(Model)
+
+	if m.PendingResume != nil {
+		t.Fatalf("expected the saved game to be discarded")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected the save file to be removed, got %v", err)
+	}
+}
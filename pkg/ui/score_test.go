@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"ctf-tool/pkg/ui/theme"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCorrectAnswerRecordsScore(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{
+			{ID: 1, Text: "Q1", Answer: "A1", Points: 200},
+			{ID: 2, Text: "Q2", Answer: "A2"},
+		},
+		FinalMessage: "EGG",
+		Scoring:      &game.ScoreRules{WrongPenalty: 10},
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	for _, input := range []string{"wrong", "A1"} {
+		m.Input.SetValue(input)
+		next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = next.(Model)
+	}
+
+	if len(m.Score.Results) != 1 {
+		t.Fatalf("expected one recorded result, got %+v", m.Score.Results)
+	}
+	if r := m.Score.Results[0]; r.QuestionID != 1 || r.Attempts != 2 || r.Points != 190 {
+		t.Fatalf("unexpected result %+v", r)
+	}
+	if got := m.hud(); got.Score != 190 || got.Solved != 1 || got.Total != 2 {
+		t.Fatalf("unexpected HUD %+v", got)
+	}
+}
+
+func TestQuestionViewFitsTerminalWithStatusLines(t *testing.T) {
+	cfg := &game.Config{
+		Questions: []game.Question{{ID: 1, Text: "Q1", Answer: "A1", Hints: []game.Hint{
+			{Text: "H1", OnRequest: true},
+		}}},
+		FinalMessage: "EGG",
+	}
+	for _, constructor := range theme.Registry {
+		m := NewModel(cfg)
+		m.State = StateQuestion
+		m.Width, m.Height = 80, 24
+		m.ActiveTheme = constructor()
+		m.Feedback = "SO CLOSE"
+		if got := strings.Count(m.View(), "\n") + 1; got > m.Height {
+			t.Errorf("%s: view is %d lines, terminal has %d", m.ActiveTheme.Name(), got, m.Height)
+		}
+	}
+}
@@ -7,4 +7,10 @@ const (
 	StateQuestion
 	StateTransition
 	StateSuccess
+	// StateSelect lets the player pick the next question of a question graph.
+	StateSelect
+	// StateBoard shows the category board of a board pack.
+	StateBoard
+	// StatePlayer asks for the player's name or team after the boot intro.
+	StatePlayer
 )
@@ -63,6 +63,7 @@ var googleColors = []lipgloss.Style{
 
 type AntigravityTheme struct {
 	BaseTheme
+	inputLayout
 	particles   []*Particle
 	mode        int
 	colorTheme  int
@@ -318,7 +319,8 @@ type TextBox struct {
 	Radius           float64
 }
 
-func (t *AntigravityTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *AntigravityTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Handle resize and initialization
@@ -339,7 +341,8 @@ func (t *AntigravityTheme) View(width, height int, q *game.Question, inputView s
 	innerW := boxW - 4
 
 	questionLines := wrapText(q.Text, innerW)
-	inputLines := wrapLabeled("> ", inputView, innerW)
+	inRows := inputRows("> ", inputs, "", innerW, max(3, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
 
 	baseH := 8
 	if hint != "" {
@@ -350,7 +353,7 @@ func (t *AntigravityTheme) View(width, height int, q *game.Question, inputView s
 		extraH += min(len(questionLines)-1, 3)
 	}
 	if len(inputLines) > 1 {
-		extraH += min(len(inputLines)-1, 2)
+		extraH += len(inputLines) - 1
 	}
 	boxH := baseH + extraH
 
@@ -654,11 +657,12 @@ func (t *AntigravityTheme) View(width, height int, q *game.Question, inputView s
 			if len(avoidBoxes) > 1 {
 				inBox := avoidBoxes[1]
 				row = inBox.Y
-				for _, line := range inputLines {
+				for i, line := range inputLines {
 					if row >= height {
 						break
 					}
 					c.SetString(inBox.X+2, row, line, textStyle)
+					t.markInput(row, inRows[i])
 					row++
 				}
 			}
@@ -687,11 +691,12 @@ func (t *AntigravityTheme) View(width, height int, q *game.Question, inputView s
 
 		row++ // gap
 
-		for _, line := range inputLines {
+		for i, line := range inputLines {
 			if row >= height {
 				break
 			}
 			c.SetString(textStartX+2, row, line, textStyle)
+			t.markInput(row, inRows[i])
 			row++
 		}
 
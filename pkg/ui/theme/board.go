@@ -0,0 +1,141 @@
+package theme
+
+import (
+	"ctf-tool/pkg/ui/canvas"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Board is the category board as the player sees it: one column per
+// category, cheapest cell on top. Col and Row are the highlighted cell.
+type Board struct {
+	Categories []BoardCategory
+	Col        int
+	Row        int
+	// Status is the HUD line shown under the board.
+	Status string
+}
+
+// BoardCategory is one column of the board.
+type BoardCategory struct {
+	Name  string
+	Cells []BoardCell
+}
+
+// BoardCell is one question on the board. Closed cells are waiting for
+// prerequisites and cannot be picked yet.
+type BoardCell struct {
+	Points int
+	Solved bool
+	Closed bool
+}
+
+// BoardStyle holds the colors RenderBoard draws with.
+type BoardStyle struct {
+	Title  lipgloss.Style
+	Header lipgloss.Style
+	Cell   lipgloss.Style
+	Cursor lipgloss.Style
+	Solved lipgloss.Style
+	Status lipgloss.Style
+}
+
+// DefaultBoardStyle is used for themes that don't implement BoardStyler.
+var DefaultBoardStyle = BoardStyle{
+	Title:  lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true),
+	Header: lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD75F")).Bold(true),
+	Cell:   lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")),
+	Cursor: lipgloss.NewStyle().Foreground(lipgloss.Color("#000000")).Background(lipgloss.Color("#00FF00")).Bold(true),
+	Solved: lipgloss.NewStyle().Foreground(lipgloss.Color("#336633")),
+	Status: lipgloss.NewStyle().Foreground(lipgloss.Color("#8DF7D9")),
+}
+
+// BoardStyler is an optional interface for themes that color the category
+// board to match their question screen.
+type BoardStyler interface {
+	BoardStyle() BoardStyle
+}
+
+// RenderBoard draws the board centered on a canvas. Cells are boxed when the
+// terminal is tall enough and fall back to one line each otherwise.
+func RenderBoard(width, height int, b Board, s BoardStyle) string {
+	c := canvas.New(width, height)
+	if len(b.Categories) == 0 || width <= 0 || height <= 0 {
+		return c.Render()
+	}
+
+	rows := 0
+	for _, cat := range b.Categories {
+		rows = max(rows, len(cat.Cells))
+	}
+
+	colW := min(18, width/len(b.Categories))
+	if colW < 4 {
+		colW = 4
+	}
+	cellH := 3
+	// Title, header, status and help take two lines each.
+	if 8+rows*cellH > height {
+		cellH = 1
+	}
+	boardW := colW * len(b.Categories)
+	boardH := 8 + rows*cellH
+	x0 := centeredStart(width, boardW)
+	y0 := centeredStart(height, boardH)
+
+	title := "CATEGORY BOARD"
+	c.SetString(centeredStart(width, len(title)), y0, title, s.Title)
+
+	for col, cat := range b.Categories {
+		x := x0 + col*colW
+		name := fitText(strings.ToUpper(cat.Name), colW-1)
+		c.SetString(x+centeredStart(colW-1, len([]rune(name))), y0+2, name, s.Header)
+
+		for row, cell := range cat.Cells {
+			y := y0 + 4 + row*cellH
+			style := s.Cell
+			label := fmt.Sprint(cell.Points)
+			switch {
+			case cell.Solved:
+				style, label = s.Solved, "----"
+			case cell.Closed:
+				style, label = s.Solved, "LOCKED"
+			}
+			if col == b.Col && row == b.Row {
+				style = s.Cursor
+			}
+			label = fitText(label, colW-3)
+
+			labelY := y
+			if cellH == 3 {
+				c.Fill(x, y, colW-1, cellH, ' ', style)
+				c.DrawBox(x, y, colW-1, cellH, style)
+				labelY = y + 1
+			} else {
+				c.Fill(x, y, colW-1, 1, ' ', style)
+			}
+			c.SetString(x+centeredStart(colW-1, len([]rune(label))), labelY, label, style)
+		}
+	}
+
+	statusY := y0 + 4 + rows*cellH + 1
+	if b.Status != "" {
+		c.SetString(centeredStart(width, len([]rune(b.Status))), statusY, b.Status, s.Status)
+	}
+	help := "[ARROWS] MOVE   [ENTER] OPEN"
+	c.SetString(centeredStart(width, len(help)), statusY+1, help, s.Status)
+	return c.Render()
+}
+
+func fitText(s string, width int) string {
+	r := []rune(s)
+	if width < 1 {
+		return ""
+	}
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width])
+}
@@ -46,6 +46,31 @@ func appendWrappedLines(lines []string, prefix, text string, width, maxLines int
 	return append(lines, wrapAndClamp(prefix, text, width, maxLines)...)
 }
 
+// cardInputs are the answer rows of a card body and the body line they
+// start on.
+type cardInputs struct {
+	rows  []inputRow
+	first int
+}
+
+// appendInputRows adds the answer fields to the body lines of a card: a lone
+// field wrapped into at most maxLines, parts and choices one row each.
+func appendInputRows(lines []string, prefix string, inputs []Input, width, maxLines int) ([]string, cardInputs) {
+	in := cardInputs{rows: inputRows(prefix, inputs, "", width, max(maxLines, inputHeight(inputs))), first: len(lines)}
+	return append(lines, rowTexts(in.rows)...), in
+}
+
+// markCard records the answer rows of a card whose top border is on screen
+// row top. Padding keeps the body one row below the border, and rows past
+// maxBodyLines were cut from the body.
+func (l *inputLayout) markCard(in cardInputs, top, maxBodyLines int) {
+	for i, r := range in.rows {
+		if line := in.first + i; line < maxBodyLines {
+			l.markInput(top+2+line, r)
+		}
+	}
+}
+
 func appendRawLines(lines []string, block string, width, maxLines int) []string {
 	if width <= 0 || maxLines <= 0 {
 		return lines
@@ -55,6 +80,37 @@ func appendRawLines(lines []string, block string, width, maxLines int) []string
 	return append(lines, clampLines(raw, maxLines, width)...)
 }
 
+// appendHintLines adds a blank separator and one wrapped entry per revealed
+// hint. The model passes the revealed hints in q.Hints and their joined form
+// in hint; hint alone is used when q carries no hint list.
+func appendHintLines(lines []string, prefix string, q *game.Question, hint string, width int) []string {
+	if hint == "" {
+		return lines
+	}
+	lines = append(lines, "")
+	if len(q.Hints) == 0 {
+		return appendWrappedLines(lines, prefix, hint, width, 2)
+	}
+	for _, h := range q.Hints {
+		lines = appendWrappedLines(lines, prefix, h.Text, width, 2)
+	}
+	return lines
+}
+
+// hudState is embedded by themes that draw the HUD inside their card.
+type hudState struct {
+	hud HUD
+}
+
+func (s *hudState) SetHUD(h HUD) { s.hud = h }
+
+func (s *hudState) appendHUDLines(lines []string, width int) []string {
+	if s.hud.Total == 0 {
+		return lines
+	}
+	return appendWrappedLines(append(lines, ""), "", s.hud.String(), width, 1)
+}
+
 func buildCardBody(lines []string, width, maxLines int) string {
 	if width <= 0 {
 		return ""
@@ -66,6 +122,8 @@ func buildCardBody(lines []string, width, maxLines int) string {
 }
 
 type AuroraGridTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -86,7 +144,8 @@ func (t *AuroraGridTheme) IsCompatible(c caps.Capabilities) bool {
 	// Requires at least basic color support; otherwise the theme loses most of its effect.
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *AuroraGridTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *AuroraGridTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	colors := []string{"#00FFD5", "#00B8FF", "#7AFF00", "#00FF7A"}
 	pulse := lipgloss.Color(colors[t.frame%len(colors)])
 	boxW := clamp(width-10, 36, 82)
@@ -109,11 +168,9 @@ func (t *AuroraGridTheme) View(width, height int, q *game.Question, inputView st
 	lines := []string{fmt.Sprintf("AURORA NODE %02d", q.ID), ""}
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 4)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "INPUT > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "HINT: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "INPUT > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "HINT: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -126,10 +183,13 @@ func (t *AuroraGridTheme) View(width, height int, q *game.Question, inputView st
 		Render(body)
 
 	stack := lipgloss.JoinVertical(lipgloss.Left, bg.String(), "", card)
+	t.markCard(in, centeredStart(height, lipgloss.Height(stack))+lipgloss.Height(bg.String())+1, maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, stack)
 }
 
 type RadarSweepTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -149,7 +209,8 @@ func (t *RadarSweepTheme) Description() string { return "Military radar panel wi
 func (t *RadarSweepTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *RadarSweepTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *RadarSweepTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	panelW := clamp(width-12, 34, 76)
 	innerW := cardInnerWidth(panelW)
 	sweepW := clamp(innerW-4, 8, 64)
@@ -166,11 +227,9 @@ func (t *RadarSweepTheme) View(width, height int, q *game.Question, inputView st
 	lines := []string{head, "[" + barPlain + "]", "", fmt.Sprintf("TARGET PROMPT %02d", q.ID)}
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "CMD > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "AUX: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "CMD > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "AUX: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -181,10 +240,13 @@ func (t *RadarSweepTheme) View(width, height int, q *game.Question, inputView st
 		Padding(1).
 		Width(panelW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type BlueprintTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -204,7 +266,8 @@ func (t *BlueprintTheme) Description() string { return "Engineering blueprint wi
 func (t *BlueprintTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *BlueprintTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *BlueprintTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	nodes := []string{"[A]", "[B]", "[C]", "[D]"}
 	active := t.frame % len(nodes)
 	nodes[active] = "<" + string(rune('A'+active)) + ">"
@@ -219,11 +282,9 @@ func (t *BlueprintTheme) View(width, height int, q *game.Question, inputView str
 	lines = append(lines, "")
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "INPUT: ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "NOTE: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "INPUT: ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "NOTE: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -234,10 +295,13 @@ func (t *BlueprintTheme) View(width, height int, q *game.Question, inputView str
 		Padding(1, 2).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type GlitchLabTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -259,7 +323,8 @@ func (t *GlitchLabTheme) Description() string {
 func (t *GlitchLabTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *GlitchLabTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *GlitchLabTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	r := []rune(q.Text)
 	symbols := []rune{'#', '%', '&', '?'}
 	for i := 0; i < len(r); i++ {
@@ -282,11 +347,9 @@ func (t *GlitchLabTheme) View(width, height int, q *game.Question, inputView str
 	lines = append(lines, "")
 	lines = appendWrappedLines(lines, "RAW: ", q.Text, innerW, 2)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "INJECT > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "RECOVERY HINT: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "INJECT > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "RECOVERY HINT: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -297,10 +360,13 @@ func (t *GlitchLabTheme) View(width, height int, q *game.Question, inputView str
 		Padding(1).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type VaultLedgerTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -322,7 +388,8 @@ func (t *VaultLedgerTheme) Description() string {
 func (t *VaultLedgerTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *VaultLedgerTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *VaultLedgerTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	dial := []string{"|", "/", "-", "\\"}
 	code := fmt.Sprintf("%02d-%02d-%02d", (q.ID+t.frame)%100, (q.ID*3+t.frame)%100, (q.ID*7+t.frame)%100)
 
@@ -339,11 +406,9 @@ func (t *VaultLedgerTheme) View(width, height int, q *game.Question, inputView s
 	}
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "KEY > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "CLUE: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "KEY > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "CLUE: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -354,10 +419,13 @@ func (t *VaultLedgerTheme) View(width, height int, q *game.Question, inputView s
 		Padding(1).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type SonarTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -377,7 +445,8 @@ func (t *SonarTheme) Description() string { return "Subsea sonar display with ro
 func (t *SonarTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *SonarTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *SonarTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	cardW := clamp(width-10, 36, 80)
 	innerW := cardInnerWidth(cardW)
 	w := innerW
@@ -398,11 +467,9 @@ func (t *SonarTheme) View(width, height int, q *game.Question, inputView string,
 	bodyLines = append(bodyLines, "", fmt.Sprintf("PING %02d", t.frame%60))
 	bodyLines = appendWrappedLines(bodyLines, "", q.Text, innerW, 2)
 	bodyLines = append(bodyLines, "")
-	bodyLines = appendWrappedLines(bodyLines, "CMD ", inputView, innerW, 2)
-	if hint != "" {
-		bodyLines = append(bodyLines, "")
-		bodyLines = appendWrappedLines(bodyLines, "ECHO: ", hint, innerW, 2)
-	}
+	bodyLines, in := appendInputRows(bodyLines, "CMD ", inputs, innerW, 2)
+	bodyLines = appendHintLines(bodyLines, "ECHO: ", q, hint, innerW)
+	bodyLines = t.appendHUDLines(bodyLines, innerW)
 	body := buildCardBody(bodyLines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -413,10 +480,13 @@ func (t *SonarTheme) View(width, height int, q *game.Question, inputView string,
 		Padding(1).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type EmberForgeTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -436,7 +506,8 @@ func (t *EmberForgeTheme) Description() string { return "Industrial forge termin
 func (t *EmberForgeTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *EmberForgeTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *EmberForgeTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	sparks := []string{" .  *   . ", "   *  .   ", " *   .   *", "  . *   . "}
 	sparkBand := sparks[t.frame%len(sparks)] + sparks[(t.frame+1)%len(sparks)] + sparks[(t.frame+2)%len(sparks)]
 
@@ -447,11 +518,9 @@ func (t *EmberForgeTheme) View(width, height int, q *game.Question, inputView st
 	lines := []string{"EMBER FORGE", truncateToWidth(sparkBand, innerW), ""}
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "HAMMER > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "COOLANT NOTE: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "HAMMER > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "COOLANT NOTE: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -462,10 +531,13 @@ func (t *EmberForgeTheme) View(width, height int, q *game.Question, inputView st
 		Padding(1).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type FrostbyteTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -485,7 +557,8 @@ func (t *FrostbyteTheme) Description() string { return "Cold system shell with p
 func (t *FrostbyteTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *FrostbyteTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *FrostbyteTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	gauge := strings.Repeat("#", (t.frame%10)+1) + strings.Repeat("-", 10-((t.frame%10)+1))
 
 	cardW := clamp(width-10, 36, 80)
@@ -499,11 +572,9 @@ func (t *FrostbyteTheme) View(width, height int, q *game.Question, inputView str
 	}
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "ICECMD > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "DEICE: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "ICECMD > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "DEICE: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -514,10 +585,13 @@ func (t *FrostbyteTheme) View(width, height int, q *game.Question, inputView str
 		Padding(1).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type NoirDossierTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -539,7 +613,8 @@ func (t *NoirDossierTheme) Description() string {
 func (t *NoirDossierTheme) IsCompatible(c caps.Capabilities) bool {
 	return true
 }
-func (t *NoirDossierTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *NoirDossierTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	marker := []string{"[ ]", "[=]", "[#]", "[=]"}[t.frame%4]
 
 	cardW := clamp(width-10, 36, 78)
@@ -549,11 +624,9 @@ func (t *NoirDossierTheme) View(width, height int, q *game.Question, inputView s
 	lines := []string{fmt.Sprintf("DOSSIER FILE %02d %s", q.ID, marker), "", "SUBJECT PROMPT:"}
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "RESPONSE: ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "NOTE: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "RESPONSE: ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "NOTE: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -564,10 +637,13 @@ func (t *NoirDossierTheme) View(width, height int, q *game.Question, inputView s
 		Padding(1, 2).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 type CircuitBoardTheme struct {
+	hudState
+	inputLayout
 	frame int
 }
 
@@ -587,7 +663,8 @@ func (t *CircuitBoardTheme) Description() string { return "PCB trace map with mo
 func (t *CircuitBoardTheme) IsCompatible(c caps.Capabilities) bool {
 	return c.ColorProfile >= termenv.ANSI
 }
-func (t *CircuitBoardTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *CircuitBoardTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	path := []string{
 		"o---+----+------o",
 		"    |    |       ",
@@ -612,11 +689,9 @@ func (t *CircuitBoardTheme) View(width, height int, q *game.Question, inputView
 	lines = append(lines, "")
 	lines = appendWrappedLines(lines, "", q.Text, innerW, 3)
 	lines = append(lines, "")
-	lines = appendWrappedLines(lines, "BUS > ", inputView, innerW, 2)
-	if hint != "" {
-		lines = append(lines, "")
-		lines = appendWrappedLines(lines, "TRACE NOTE: ", hint, innerW, 2)
-	}
+	lines, in := appendInputRows(lines, "BUS > ", inputs, innerW, 2)
+	lines = appendHintLines(lines, "TRACE NOTE: ", q, hint, innerW)
+	lines = t.appendHUDLines(lines, innerW)
 	body := buildCardBody(lines, innerW, maxBodyLines)
 
 	card := lipgloss.NewStyle().
@@ -627,6 +702,7 @@ func (t *CircuitBoardTheme) View(width, height int, q *game.Question, inputView
 		Padding(1).
 		Width(cardW).
 		Render(body)
+	t.markCard(in, centeredStart(height, lipgloss.Height(card)), maxBodyLines)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
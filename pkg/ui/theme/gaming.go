@@ -13,13 +13,15 @@ import (
 
 type GameboyTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewGameboyTheme() Theme                { return &GameboyTheme{} }
 func (t *GameboyTheme) Name() string        { return "Dot Matrix Game" }
 func (t *GameboyTheme) Description() string { return "160x144 pixels of fun" }
 
-func (t *GameboyTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *GameboyTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// GB Palette
@@ -44,8 +46,9 @@ func (t *GameboyTheme) View(width, height int, q *game.Question, inputView strin
 	// Screen content background
 	c.Fill(vpX, vpY, vpW, vpH, ' ', light)
 
-	// Pokemon-style text box
-	boxH := 6
+	// Pokemon-style text box, one row taller for every extra part or choice
+	inRows := inputRows("> ", inputs, "", vpW-4, min(inputHeight(inputs), 6))
+	boxH := 5 + max(len(inRows), 1)
 	c.DrawBox(vpX, vpY+vpH-boxH, vpW, boxH, darkest)
 	dialogW := vpW - 4
 
@@ -56,9 +59,9 @@ func (t *GameboyTheme) View(width, height int, q *game.Question, inputView strin
 		c.SetString(vpX+2, vpY+4+i, line, darkest)
 	}
 
-	inputLines := clampLines(wrapLabeled("> ", inputView, dialogW), 1, dialogW)
-	if len(inputLines) > 0 {
-		c.SetString(vpX+2, vpY+vpH-boxH+2, inputLines[0], darkest)
+	for i, r := range inRows {
+		c.SetString(vpX+2, vpY+vpH-boxH+2+i, r.text, darkest)
+		t.markInput(vpY+vpH-boxH+2+i, r)
 	}
 
 	// Sprite placeholder
@@ -67,7 +70,7 @@ func (t *GameboyTheme) View(width, height int, q *game.Question, inputView strin
 	if hint != "" {
 		hintLines := clampLines(wrapLabeled("HINT: ", hint, dialogW), 1, dialogW)
 		if len(hintLines) > 0 {
-			c.SetString(vpX+2, vpY+vpH-boxH+4, hintLines[0], dark)
+			c.SetString(vpX+2, vpY+vpH-2, hintLines[0], dark)
 		}
 	}
 
@@ -78,13 +81,15 @@ func (t *GameboyTheme) View(width, height int, q *game.Question, inputView strin
 
 type NESTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewNESTheme() Theme                { return &NESTheme{} }
 func (t *NESTheme) Name() string        { return "8-Bit RPG" }
 func (t *NESTheme) Description() string { return "It's dangerous to go alone" }
 
-func (t *NESTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *NESTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	white := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Background(lipgloss.Color("#000000"))
@@ -103,10 +108,11 @@ func (t *NESTheme) View(width, height int, q *game.Question, inputView string, h
 
 	// Dialog Box
 	boxW := min(50, width-4)
-	boxH := 10
+	innerW := boxW - 4
+	inRows := inputRows("▶ ", inputs, "", innerW, min(inputHeight(inputs), 6))
+	boxH := 9 + max(len(inRows), 1)
 	boxX := (width - boxW) / 2
 	boxY := height - boxH - 2
-	innerW := boxW - 4
 
 	// Black background for box
 	c.Fill(boxX, boxY, boxW, boxH, ' ', white)
@@ -129,9 +135,12 @@ func (t *NESTheme) View(width, height int, q *game.Question, inputView string, h
 	if row < boxY+boxH-2 {
 		row++
 	}
-	inputLines := clampLines(wrapLabeled("▶ ", inputView, innerW), 1, innerW)
-	if len(inputLines) > 0 && row < boxY+boxH-1 {
-		c.SetString(boxX+2, row, inputLines[0], white)
+	for _, r := range inRows {
+		if row >= boxY+boxH-1 {
+			break
+		}
+		c.SetString(boxX+2, row, r.text, white)
+		t.markInput(row, r)
 		row++
 	}
 
@@ -153,6 +162,7 @@ func (t *NESTheme) View(width, height int, q *game.Question, inputView string, h
 
 type SNESTheme struct {
 	BaseTheme
+	inputLayout
 	rotation float64
 }
 
@@ -168,7 +178,8 @@ func (t *SNESTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *SNESTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *SNESTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Horizon
@@ -211,9 +222,10 @@ func (t *SNESTheme) View(width, height int, q *game.Question, inputView string,
 	}
 
 	inputY := boxY + len(questionLines) + 1
-	inputLines := clampLines(wrapLabeled("> ", inputView, textW), 1, textW)
-	if len(inputLines) > 0 {
-		c.SetString(boxX, inputY, inputLines[0], style)
+	inRows := inputRows("> ", inputs, "", textW, min(inputHeight(inputs), 6))
+	for i, r := range inRows {
+		c.SetString(boxX, inputY+i, r.text, style)
+		t.markInput(inputY+i, r)
 	}
 
 	// Hint with bounce animation
@@ -233,7 +245,7 @@ func (t *SNESTheme) View(width, height int, q *game.Question, inputView string,
 			displayHint = truncateToWidth(hint, hintWidth)
 		}
 		hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
-		c.SetString(boxX, inputY+2, hintPrefix+displayHint, hintStyle)
+		c.SetString(boxX, inputY+max(len(inRows), 1)+1, hintPrefix+displayHint, hintStyle)
 	}
 
 	return c.Render()
@@ -243,13 +255,27 @@ func (t *SNESTheme) View(width, height int, q *game.Question, inputView string,
 
 type FalloutTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewFalloutTheme() Theme                { return &FalloutTheme{} }
 func (t *FalloutTheme) Name() string        { return "Pip-Boy 3000" }
 func (t *FalloutTheme) Description() string { return "Vault-Tec Approved" }
 
-func (t *FalloutTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *FalloutTheme) BoardStyle() BoardStyle {
+	green := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
+	return BoardStyle{
+		Title:  green.Bold(true),
+		Header: green.Bold(true),
+		Cell:   green.Background(lipgloss.Color("#001100")),
+		Cursor: lipgloss.NewStyle().Foreground(lipgloss.Color("#001100")).Background(lipgloss.Color("#00FF00")),
+		Solved: lipgloss.NewStyle().Foreground(lipgloss.Color("#003300")),
+		Status: green,
+	}
+}
+
+func (t *FalloutTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	green := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Background(lipgloss.Color("#001100"))
@@ -312,12 +338,12 @@ func (t *FalloutTheme) View(width, height int, q *game.Question, inputView strin
 		row++
 	}
 
-	inputLines := wrapAndClamp("> ", inputView+"_", contentW, 2)
-	for _, line := range inputLines {
+	for _, r := range inputRows("> ", inputs, "_", contentW, max(2, inputHeight(inputs))) {
 		if row >= frame.y+frame.h-2 {
 			break
 		}
-		c.SetString(contentX, row, line, green)
+		c.SetString(contentX, row, r.text, green)
+		t.markInput(row, r)
 		row++
 	}
 
@@ -344,13 +370,15 @@ func (t *FalloutTheme) View(width, height int, q *game.Question, inputView strin
 
 type DeusExTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewDeusExTheme() Theme                { return &DeusExTheme{} }
 func (t *DeusExTheme) Name() string        { return "Augmented Reality" }
 func (t *DeusExTheme) Description() string { return "I never asked for this" }
 
-func (t *DeusExTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *DeusExTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	gold := lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AF37")) // Gold
@@ -404,12 +432,12 @@ func (t *DeusExTheme) View(width, height int, q *game.Question, inputView string
 		row++
 	}
 
-	inputLines := wrapAndClamp("INPUT: ", inputView, contentW, 2)
-	for _, line := range inputLines {
+	for _, r := range inputRows("INPUT: ", inputs, "", contentW, max(2, inputHeight(inputs))) {
 		if row >= height {
 			break
 		}
-		c.SetString(contentX, row, line, gold)
+		c.SetString(contentX, row, r.text, gold)
+		t.markInput(row, r)
 		row++
 	}
 
@@ -17,6 +17,7 @@ import (
 
 type SneakersTheme struct {
 	BaseTheme
+	inputLayout
 	codeStream []rune
 	tick       int
 }
@@ -25,6 +26,18 @@ func NewSneakersTheme() Theme                { return &SneakersTheme{} }
 func (t *SneakersTheme) Name() string        { return "Sneakers" }
 func (t *SneakersTheme) Description() string { return "SETEC ASTRONOMY" }
 
+func (t *SneakersTheme) BoardStyle() BoardStyle {
+	green := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+	return BoardStyle{
+		Title:  green,
+		Header: green,
+		Cell:   green,
+		Cursor: lipgloss.NewStyle().Foreground(lipgloss.Color("#000000")).Background(lipgloss.Color("#00FF00")).Bold(true),
+		Solved: lipgloss.NewStyle().Foreground(lipgloss.Color("#004400")),
+		Status: green,
+	}
+}
+
 func (t *SneakersTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	if _, ok := msg.(game.TickMsg); ok {
 		t.tick++
@@ -33,7 +46,8 @@ func (t *SneakersTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *SneakersTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *SneakersTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	green := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
@@ -53,7 +67,8 @@ func (t *SneakersTheme) View(width, height int, q *game.Question, inputView stri
 	innerW := boxW - 4
 
 	questionLines := wrapText(q.Text, innerW)
-	inputLines := wrapLabeled("> ", inputView, innerW)
+	inRows := inputRows("> ", inputs, "", innerW, max(3, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
 
 	baseH := 10
 	if hint != "" {
@@ -64,7 +79,7 @@ func (t *SneakersTheme) View(width, height int, q *game.Question, inputView stri
 		extraH += min(len(questionLines)-1, 3)
 	}
 	if len(inputLines) > 1 {
-		extraH += min(len(inputLines)-1, 2)
+		extraH += len(inputLines) - 1
 	}
 	boxH := baseH + extraH
 	maxBoxH := height - 2
@@ -117,11 +132,12 @@ func (t *SneakersTheme) View(width, height int, q *game.Question, inputView stri
 		row++
 	}
 
-	for _, line := range inputLines {
+	for i, line := range inputLines {
 		if row >= boxY+boxH-1 {
 			break
 		}
 		c.SetString(boxX+2, row, line, green)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -176,6 +192,7 @@ func (t *SneakersTheme) View(width, height int, q *game.Question, inputView stri
 
 type HackersTheme struct {
 	BaseTheme
+	inputLayout
 	rotation float64
 }
 
@@ -191,7 +208,8 @@ func (t *HackersTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *HackersTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *HackersTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Psychedelic colors
@@ -220,7 +238,8 @@ func (t *HackersTheme) View(width, height int, q *game.Question, inputView strin
 	panelX := centeredStart(width, panelW)
 
 	questionLines := wrapAndClamp("", q.Text, panelW, 3)
-	inputLines := wrapAndClamp("> ", inputView, panelW, 2)
+	inRows := inputRows("> ", inputs, "", panelW, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
 
 	panelRows := 1 + 1 + len(questionLines) + 1 + len(inputLines)
 	if hint != "" {
@@ -244,11 +263,12 @@ func (t *HackersTheme) View(width, height int, q *game.Question, inputView strin
 		row++
 	}
 
-	for _, line := range inputLines {
+	for i, line := range inputLines {
 		if row >= height {
 			break
 		}
 		c.SetString(panelX, row, line, bgStyle)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -298,6 +318,7 @@ func (t *HackersTheme) View(width, height int, q *game.Question, inputView strin
 
 type MrRobotTheme struct {
 	BaseTheme
+	inputLayout
 	tick int
 }
 
@@ -313,7 +334,8 @@ func (t *MrRobotTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *MrRobotTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *MrRobotTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Minimalist, authentic terminal
@@ -346,12 +368,14 @@ func (t *MrRobotTheme) View(width, height int, q *game.Question, inputView strin
 		row++
 	}
 
-	inputLines := wrapAndClamp("> ", inputView, lineWidth, 2)
-	for _, line := range inputLines {
+	inRows := inputRows("> ", inputs, "", lineWidth, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
 		if row >= height {
 			break
 		}
 		c.SetString(0, row, line, bg)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -389,6 +413,7 @@ func (t *MrRobotTheme) View(width, height int, q *game.Question, inputView strin
 
 type WargamesTheme struct {
 	BaseTheme
+	inputLayout
 	blink bool
 }
 
@@ -404,7 +429,8 @@ func (t *WargamesTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *WargamesTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *WargamesTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Standard monochromatic phosphor
@@ -446,12 +472,14 @@ func (t *WargamesTheme) View(width, height int, q *game.Question, inputView stri
 		cursor = "█"
 	}
 
-	inputLines := wrapAndClamp("ENTER MOVE: ", inputView+cursor, menuW, 2)
-	for _, line := range inputLines {
+	inRows := inputRows("ENTER MOVE: ", inputs, cursor, menuW, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
 		if row >= height {
 			break
 		}
 		c.SetString(menuX, row, line, cyan)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -476,6 +504,7 @@ func (t *WargamesTheme) View(width, height int, q *game.Question, inputView stri
 
 type CryptoTheme struct {
 	BaseTheme
+	inputLayout
 	hashRate int
 	frame    int
 }
@@ -493,7 +522,8 @@ func (t *CryptoTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *CryptoTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *CryptoTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	gold := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700"))
@@ -506,16 +536,17 @@ func (t *CryptoTheme) View(width, height int, q *game.Question, inputView string
 		c.SetString(0, y, hash+hash+hash, dim)
 	}
 
-	// Overlay Box - expanded for hint
+	// Overlay Box - expanded for hint and for every extra part or choice
 	boxW := min(70, width-4)
 	innerW := boxW - 4
-	boxH := 12
-	boxX := (width - boxW) / 2
-	boxY := (height - boxH) / 2
+	inRows := inputRows("NONCE: ", inputs, "", innerW, min(inputHeight(inputs), 6))
+	extraH := max(len(inRows), 1) - 1
+	boxH := 12 + extraH
 	if hint != "" {
-		boxH = 14
-		boxY = (height - boxH) / 2
+		boxH += 2
 	}
+	boxX := (width - boxW) / 2
+	boxY := (height - boxH) / 2
 
 	c.Fill(boxX, boxY, boxW, boxH, ' ', lipgloss.NewStyle().Background(lipgloss.Color("#000000")))
 	c.DrawBox(boxX, boxY, boxW, boxH, gold)
@@ -528,9 +559,9 @@ func (t *CryptoTheme) View(width, height int, q *game.Question, inputView string
 		c.SetString(boxX+2, boxY+5+i, line, green)
 	}
 
-	nonceLines := clampLines(wrapLabeled("NONCE: ", inputView, innerW), 1, innerW)
-	if len(nonceLines) > 0 {
-		c.SetString(boxX+2, boxY+7, nonceLines[0], green)
+	for i, r := range inRows {
+		c.SetString(boxX+2, boxY+7+i, r.text, green)
+		t.markInput(boxY+7+i, r)
 	}
 
 	// Hint: static when it fits, slow marquee only on overflow
@@ -568,7 +599,7 @@ func (t *CryptoTheme) View(width, height int, q *game.Question, inputView string
 				displayHint = string(r[offset : offset+availableHintRunes])
 			}
 
-			c.SetString(boxX+2, boxY+9, hintPrefix+displayHint, green)
+			c.SetString(boxX+2, boxY+9+extraH, hintPrefix+displayHint, green)
 		}
 	}
 
@@ -42,13 +42,13 @@ func TestCryptoThemeHintStaticWhenFits(t *testing.T) {
 	q := &game.Question{ID: 1, Text: "Hash challenge", Hint: "Try terminal command"}
 	width, height := 120, 28
 
-	first := extractClueLine(theme.View(width, height, q, "nonce123", q.Hint))
+	first := extractClueLine(theme.View(width, height, q, SingleInput("nonce123"), q.Hint))
 	if first == "" {
 		t.Fatalf("expected clue line to be visible")
 	}
 
 	theme = advanceCryptoFrames(theme, 12)
-	second := extractClueLine(theme.View(width, height, q, "nonce123", q.Hint))
+	second := extractClueLine(theme.View(width, height, q, SingleInput("nonce123"), q.Hint))
 	if second == "" {
 		t.Fatalf("expected clue line to remain visible")
 	}
@@ -67,19 +67,19 @@ func TestCryptoThemeHintScrollsSlowlyWhenOverflowing(t *testing.T) {
 	q := &game.Question{ID: 1, Text: "Hash challenge", Hint: "This hint is intentionally very long so it cannot fit in the blockchain clue panel and should scroll slowly"}
 	width, height := 80, 24
 
-	start := extractClueLine(theme.View(width, height, q, "nonce123", q.Hint))
+	start := extractClueLine(theme.View(width, height, q, SingleInput("nonce123"), q.Hint))
 	if start == "" {
 		t.Fatalf("expected clue line to be visible")
 	}
 
 	theme = advanceCryptoFrames(theme, 3)
-	soon := extractClueLine(theme.View(width, height, q, "nonce123", q.Hint))
+	soon := extractClueLine(theme.View(width, height, q, SingleInput("nonce123"), q.Hint))
 	if soon != start {
 		t.Fatalf("expected overflowing hint to scroll slower than every frame")
 	}
 
 	theme = advanceCryptoFrames(theme, 24)
-	later := extractClueLine(theme.View(width, height, q, "nonce123", q.Hint))
+	later := extractClueLine(theme.View(width, height, q, SingleInput("nonce123"), q.Hint))
 	if later == start {
 		t.Fatalf("expected overflowing hint to eventually scroll")
 	}
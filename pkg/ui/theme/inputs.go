@@ -0,0 +1,109 @@
+package theme
+
+import "strings"
+
+// inputRow is one drawn row of the answer fields and the index of the Input
+// it shows.
+type inputRow struct {
+	text  string
+	index int
+}
+
+// inputRows lays the answer fields out in rows of width. A lone field wraps
+// after prefix into at most maxLines rows. Parts and choices take one row
+// each, parts as "name: value" (marked [OK] once solved) and choices as
+// "2) value", with prefix on the focused row and an indent of its width on
+// the others. When they don't fit in maxLines, the rows around the focused
+// one are kept. cursor follows the value being typed.
+func inputRows(prefix string, inputs []Input, cursor string, width, maxLines int) []inputRow {
+	if width <= 0 || maxLines <= 0 || len(inputs) == 0 {
+		return nil
+	}
+	if loneInput(inputs) {
+		var rows []inputRow
+		for _, line := range wrapAndClamp(prefix, inputs[0].Value+cursor, width, maxLines) {
+			rows = append(rows, inputRow{line, 0})
+		}
+		return rows
+	}
+
+	indent := strings.Repeat(" ", runeLen(prefix))
+	focus := 0
+	rows := make([]inputRow, len(inputs))
+	for i, in := range inputs {
+		text := in.Label + ": " + in.Value
+		if in.Choice {
+			text = ChoiceLabel(in)
+		}
+		label := indent
+		if in.Focused {
+			label, focus = prefix, i
+			if !in.Choice {
+				text += cursor
+			}
+		}
+		if in.Solved {
+			text += " [OK]"
+		}
+		rows[i] = inputRow{truncateWithEllipsis(label+text, width), i}
+	}
+	if len(rows) > maxLines {
+		start := min(max(focus-maxLines/2, 0), len(rows)-maxLines)
+		rows = rows[start : start+maxLines]
+	}
+	return rows
+}
+
+// rowTexts is the text of rows, for themes that build their view from
+// lines.
+func rowTexts(rows []inputRow) []string {
+	lines := make([]string, len(rows))
+	for i, r := range rows {
+		lines[i] = r.text
+	}
+	return lines
+}
+
+// inputHeight is how many rows the answer fields want: one for a lone
+// field, one per part or choice otherwise.
+func inputHeight(inputs []Input) int {
+	if loneInput(inputs) {
+		return 1
+	}
+	return max(len(inputs), 1)
+}
+
+// loneInput reports whether inputs is the single field of a question without
+// parts or choices.
+func loneInput(inputs []Input) bool {
+	return len(inputs) == 1 && inputs[0].Label == ""
+}
+
+// inputLayout is embedded by themes to remember the screen row of every
+// answer field they drew (see InputLocator).
+type inputLayout struct {
+	height  int
+	inputAt map[int]int
+}
+
+// InputAt returns the index of the answer field drawn on row y.
+func (l *inputLayout) InputAt(y int) (int, bool) {
+	i, ok := l.inputAt[y]
+	return i, ok
+}
+
+// clearInputs forgets the rows of the previous view and starts a view of
+// height rows.
+func (l *inputLayout) clearInputs(height int) {
+	l.height = height
+	l.inputAt = make(map[int]int)
+}
+
+// markInput records that row r was drawn on screen row y. Rows off the view
+// were never seen, so they are skipped.
+func (l *inputLayout) markInput(y int, r inputRow) {
+	if y < 0 || y >= l.height {
+		return
+	}
+	l.inputAt[y] = r.index
+}
@@ -3,17 +3,60 @@ package theme
 import (
 	"ctf-tool/pkg/game"
 	"ctf-tool/pkg/ui/caps"
+	"fmt"
+	"strings"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type Theme interface {
 	Init() tea.Cmd
 	Update(msg tea.Msg) (Theme, tea.Cmd)
-	View(width, height int, q *game.Question, inputView string, hint string) string
+	// View renders the question. inputs holds the answer fields, one per
+	// part of a multi-part question or one per choice of a multiple-choice
+	// question; themes draw a lone field where the answer goes and the
+	// others one row each.
+	// q.Hints holds only the hints revealed so far, in order; hint is the
+	// same hints joined into one line for themes that have room for a single
+	// hint.
+	View(width, height int, q *game.Question, inputs []Input, hint string) string
 	Name() string
 	Description() string
 }
 
+// Input is one answer field of the question on screen. Questions without
+// parts have a single Input without a Label. The choices of a
+// multiple-choice question are Inputs with Choice set, labeled with their
+// number; the selected one is Focused.
+type Input struct {
+	Label   string
+	Value   string
+	Focused bool
+	Solved  bool
+	Choice  bool
+}
+
+// SingleInput is the field list of a question without parts.
+func SingleInput(value string) []Input {
+	return []Input{{Value: value, Focused: true}}
+}
+
+// ChoiceInputs is the field list of a multiple-choice question with the
+// choice at index selected.
+func ChoiceInputs(choices []string, selected int) []Input {
+	inputs := make([]Input, len(choices))
+	for i, c := range choices {
+		inputs[i] = Input{Label: fmt.Sprint(i + 1), Value: c, Focused: i == selected, Choice: true}
+	}
+	return inputs
+}
+
+// ChoiceLabel renders a choice as "2) value".
+func ChoiceLabel(in Input) string {
+	return in.Label + ") " + in.Value
+}
+
 // CapabilityAware is an optional interface that themes can implement to declare
 // whether they should be used in the current terminal environment.
 //
@@ -22,6 +65,79 @@ type CapabilityAware interface {
 	IsCompatible(c caps.Capabilities) bool
 }
 
+// HUD is the player's progress, for themes that draw it next to the question.
+// QuestionLeft and GameLeft are only meaningful when QuestionTimed or
+// GameTimed is set.
+type HUD struct {
+	Score  int
+	Solved int
+	Total  int
+
+	QuestionTimed bool
+	QuestionLeft  time.Duration
+	GameTimed     bool
+	GameLeft      time.Duration
+
+	// LevelCode continues the game at this question from the intro screen.
+	LevelCode string
+
+	// Player is the player's name or team, if they gave one.
+	Player string
+
+	// LockedLeft is what remains of a guessing lockout.
+	LockedLeft time.Duration
+}
+
+// String renders the HUD as a single status line.
+func (h HUD) String() string {
+	parts := []string{fmt.Sprintf("SCORE %d", h.Score), fmt.Sprintf("SOLVED %d/%d", h.Solved, h.Total)}
+	if h.QuestionTimed {
+		parts = append(parts, "TIME "+FormatCountdown(h.QuestionLeft))
+	}
+	if h.GameTimed {
+		parts = append(parts, "EVENT "+FormatCountdown(h.GameLeft))
+	}
+	if h.LockedLeft > 0 {
+		parts = append(parts, "LOCKED "+FormatCountdown(h.LockedLeft))
+	}
+	if h.LevelCode != "" {
+		parts = append(parts, "CODE "+h.LevelCode)
+	}
+	if h.Player != "" {
+		parts = append(parts, "PLAYER "+h.Player)
+	}
+	return strings.Join(parts, "  ")
+}
+
+// FormatCountdown renders a remaining duration as M:SS, or H:MM:SS from an
+// hour up. Partial seconds round up so the display reaches 0:00 only when
+// time is out.
+func FormatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	total := int((d + time.Second - 1) / time.Second)
+	h, m, s := total/3600, total/60%60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+// InputLocator is an optional interface for themes that know where they drew
+// the answer fields. InputAt returns the index into inputs of the field the
+// last View drew on row y, so a click there can pick it.
+type InputLocator interface {
+	InputAt(y int) (int, bool)
+}
+
+// HUDAware is an optional interface for themes that render the HUD
+// themselves. The model calls SetHUD before every View; themes that don't
+// implement it get a status line below their view instead.
+type HUDAware interface {
+	SetHUD(h HUD)
+}
+
 type Constructor func() Theme
 
 var Registry = []Constructor{}
@@ -16,6 +16,7 @@ import (
 
 type BBSTheme struct {
 	BaseTheme
+	inputLayout
 	frame int
 }
 
@@ -31,7 +32,8 @@ func (t *BBSTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *BBSTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *BBSTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// ANSI Colors
@@ -55,9 +57,9 @@ func (t *BBSTheme) View(width, height int, q *game.Question, inputView string, h
 		y++
 	}
 
-	// Box
+	// Box, reaching further down for every extra part or choice
 	boxY := y + 2
-	boxH := height - boxY - 4
+	boxH := min(height-boxY-5+inputHeight(inputs), height-boxY-2)
 	c.DrawBox(5, boxY, width-10, boxH, magenta)
 	innerW := width - 14
 	if innerW < 10 {
@@ -80,12 +82,14 @@ func (t *BBSTheme) View(width, height int, q *game.Question, inputView string, h
 		row++
 	}
 
-	responseLines := clampLines(wrapLabeled("Response: ", inputView, innerW), 2, innerW)
-	for _, line := range responseLines {
+	inRows := inputRows("Response: ", inputs, "", innerW, min(max(2, inputHeight(inputs)), boxY+boxH-1-row))
+	responseLines := rowTexts(inRows)
+	for i, line := range responseLines {
 		if row >= boxY+boxH-1 {
 			break
 		}
 		c.SetString(7, row, line, cyan)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -122,6 +126,7 @@ func (t *BBSTheme) View(width, height int, q *game.Question, inputView string, h
 
 type StrangerThingsTheme struct {
 	BaseTheme
+	inputLayout
 	litChar rune
 	frame   int
 }
@@ -140,7 +145,8 @@ func (t *StrangerThingsTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *StrangerThingsTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *StrangerThingsTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Alphabet wall
@@ -171,7 +177,7 @@ func (t *StrangerThingsTheme) View(width, height int, q *game.Question, inputVie
 
 	panelW := boundedSpan(width, 4, 20, 56)
 	panelX := centeredStart(width, panelW)
-	row := height - 7
+	row := height - 6 - inputHeight(inputs)
 	if row < startY+10 {
 		row = startY + 10
 	}
@@ -189,12 +195,14 @@ func (t *StrangerThingsTheme) View(width, height int, q *game.Question, inputVie
 		row++
 	}
 
-	inputLines := wrapAndClamp("> ", inputView, panelW, 1)
-	for _, line := range inputLines {
+	inRows := inputRows("> ", inputs, "", panelW, min(inputHeight(inputs), height-row))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
 		if row >= height {
 			break
 		}
 		c.SetString(panelX, row, line, lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")))
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -217,6 +225,7 @@ func (t *StrangerThingsTheme) View(width, height int, q *game.Question, inputVie
 
 type BladeRunnerTheme struct {
 	BaseTheme
+	inputLayout
 	frame int
 }
 
@@ -232,7 +241,8 @@ func (t *BladeRunnerTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *BladeRunnerTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *BladeRunnerTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	orange := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF4500"))
@@ -286,12 +296,14 @@ func (t *BladeRunnerTheme) View(width, height int, q *game.Question, inputView s
 	if row < height {
 		row++
 	}
-	responseLines := wrapAndClamp("EMOTIONAL RESPONSE: ", inputView, contentW, 2)
-	for _, line := range responseLines {
+	inRows := inputRows("EMOTIONAL RESPONSE: ", inputs, "", contentW, max(2, inputHeight(inputs)))
+	responseLines := rowTexts(inRows)
+	for i, line := range responseLines {
 		if row >= height {
 			break
 		}
 		c.SetString(contentX, row, line, orange)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -320,6 +332,7 @@ func (t *BladeRunnerTheme) View(width, height int, q *game.Question, inputView s
 
 type BootTheme struct {
 	BaseTheme
+	inputLayout
 	memCount int
 }
 
@@ -338,7 +351,8 @@ func (t *BootTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *BootTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *BootTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	white := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF"))
@@ -375,12 +389,14 @@ func (t *BootTheme) View(width, height int, q *game.Question, inputView string,
 	if row < height {
 		row++
 	}
-	loginLines := wrapAndClamp("login: ", inputView, lineW, 2)
-	for _, line := range loginLines {
+	inRows := inputRows("login: ", inputs, "", lineW, max(2, inputHeight(inputs)))
+	loginLines := rowTexts(inRows)
+	for i, line := range loginLines {
 		if row >= height {
 			break
 		}
 		c.SetString(lineX, row, line, white)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -416,6 +432,7 @@ func (t *BootTheme) View(width, height int, q *game.Question, inputView string,
 
 type GhostInShellTheme struct {
 	BaseTheme
+	inputLayout
 	frame int
 }
 
@@ -431,7 +448,8 @@ func (t *GhostInShellTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *GhostInShellTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *GhostInShellTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	if width <= 0 || height <= 0 {
 		return ""
 	}
@@ -469,12 +487,14 @@ func (t *GhostInShellTheme) View(width, height int, q *game.Question, inputView
 	if row < height {
 		row++
 	}
-	inputLines := wrapAndClamp("> ", inputView, panelW, 2)
-	for _, line := range inputLines {
+	inRows := inputRows("> ", inputs, "", panelW, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
 		if row >= height {
 			break
 		}
 		c.SetString(panelX, row, line, green)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
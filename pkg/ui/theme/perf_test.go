@@ -89,7 +89,7 @@ func TestAllThemesStillRenderCorrectly(t *testing.T) {
 					}
 				}
 
-				view := theme.View(width, height, q, input, q.Hint)
+				view := theme.View(width, height, q, SingleInput(input), q.Hint)
 				clean := stripANSI(view)
 
 				// Must produce the right number of lines.
@@ -129,7 +129,7 @@ func BenchmarkAntigravityDialogView(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		theme.Update(game.TickMsg(time.Now()))
-		_ = theme.View(80, 24, q, "hunter2", "hint")
+		_ = theme.View(80, 24, q, SingleInput("hunter2"), "hint")
 	}
 }
 
@@ -148,7 +148,7 @@ func BenchmarkAntigravityAvoidanceView(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		theme.Update(game.TickMsg(time.Now()))
-		_ = theme.View(80, 24, q, "hunter2", "hint")
+		_ = theme.View(80, 24, q, SingleInput("hunter2"), "hint")
 	}
 }
 
@@ -3,7 +3,6 @@ package theme
 import (
 	"ctf-tool/pkg/game"
 	"ctf-tool/pkg/ui/canvas"
-	"fmt"
 	"math"
 	"math/rand"
 	"strings"
@@ -17,6 +16,7 @@ import (
 
 type C64Theme struct {
 	BaseTheme
+	inputLayout
 	cursorFlash bool
 }
 
@@ -42,7 +42,8 @@ func (t *C64Theme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *C64Theme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *C64Theme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	blue := lipgloss.NewStyle().Foreground(lipgloss.Color("#70A4B2")).Background(lipgloss.Color("#352879"))
@@ -91,10 +92,12 @@ func (t *C64Theme) View(width, height int, q *game.Question, inputView string, h
 	if t.cursorFlash {
 		cursor = "█"
 	}
-	inputLines := clampLines(wrapLabeled("> ", inputView+cursor, innerW), 2, innerW)
-	inputY := viewportY + viewportH - 5
+	inRows := inputRows("> ", inputs, cursor, innerW, min(max(2, inputHeight(inputs)), 5))
+	inputLines := rowTexts(inRows)
+	inputY := viewportY + viewportH - 3 - max(len(inputLines), 2)
 	for i, line := range inputLines {
 		c.SetString(viewportX+1, inputY+i, line, blue)
+		t.markInput(inputY+i, inRows[i])
 	}
 
 	if hint != "" {
@@ -112,13 +115,27 @@ func (t *C64Theme) View(width, height int, q *game.Question, inputView string, h
 
 type DOSTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewDOSTheme() Theme                { return &DOSTheme{} }
 func (t *DOSTheme) Name() string        { return "MS-DOS" }
 func (t *DOSTheme) Description() string { return "C:\\>" }
 
-func (t *DOSTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *DOSTheme) BoardStyle() BoardStyle {
+	bg := lipgloss.NewStyle().Background(lipgloss.Color("#0000AA")).Foreground(lipgloss.Color("#AAAAAA"))
+	return BoardStyle{
+		Title:  bg.Foreground(lipgloss.Color("#FFFFFF")).Bold(true),
+		Header: lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF55")),
+		Cell:   bg,
+		Cursor: lipgloss.NewStyle().Background(lipgloss.Color("#AAAAAA")).Foreground(lipgloss.Color("#0000AA")),
+		Solved: lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")),
+		Status: lipgloss.NewStyle().Foreground(lipgloss.Color("#AAAAAA")),
+	}
+}
+
+func (t *DOSTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	bg := lipgloss.NewStyle().Background(lipgloss.Color("#0000AA")).Foreground(lipgloss.Color("#AAAAAA")) // Blue background
@@ -126,9 +143,20 @@ func (t *DOSTheme) View(width, height int, q *game.Question, inputView string, h
 
 	c.Fill(0, 0, width, height, '░', bg)
 
-	// Main Window
+	// Main Window, one row taller for every extra part or choice. A lone
+	// field is boxed in brackets; listed ones are marked with a pointer.
+	prefix, left, right := "> ", "", ""
+	if loneInput(inputs) {
+		prefix, left, right = "", "[", "]"
+	}
 	winW := min(60, width-2)
-	winH := min(18, height-2)
+	inputFieldW := winW - 18
+	if inputFieldW < 4 {
+		inputFieldW = 4
+	}
+	inRows := inputRows(prefix, inputs, "_", inputFieldW-2, min(inputHeight(inputs), 5))
+	extraH := max(len(inRows), 1) - 1
+	winH := min(18+extraH, height-2)
 	winX := (width - winW) / 2
 	winY := (height - winH) / 2
 	innerW := winW - 4
@@ -153,12 +181,10 @@ func (t *DOSTheme) View(width, height int, q *game.Question, inputView string, h
 
 	// Input Field
 	c.SetString(winX+2, winY+6, "Enter Value:", bg)
-	inputFieldW := winW - 18
-	if inputFieldW < 4 {
-		inputFieldW = 4
+	for i, r := range inRows {
+		c.SetString(winX+15, winY+6+i, left+r.text+right, hl)
+		t.markInput(winY+6+i, r)
 	}
-	inputValue := truncateWithEllipsis(inputView, inputFieldW-3)
-	c.SetString(winX+15, winY+6, "["+inputValue+"_]", hl)
 
 	// Bottom Bar
 	bottom := "F1: Help  F10: Save & Exit  Esc: Exit"
@@ -167,7 +193,7 @@ func (t *DOSTheme) View(width, height int, q *game.Question, inputView string, h
 	if hint != "" {
 		hintLines := clampLines(wrapLabeled("Hint: ", hint, innerW), 3, innerW)
 		for i, line := range hintLines {
-			c.SetString(winX+2, winY+10+i, line, bg)
+			c.SetString(winX+2, winY+10+extraH+i, line, bg)
 		}
 	}
 
@@ -178,13 +204,15 @@ func (t *DOSTheme) View(width, height int, q *game.Question, inputView string, h
 
 type AmigaTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewAmigaTheme() Theme                { return &AmigaTheme{} }
 func (t *AmigaTheme) Name() string        { return "Amiga Workbench" }
 func (t *AmigaTheme) Description() string { return "Guru Meditation" }
 
-func (t *AmigaTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *AmigaTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	wbBlue := lipgloss.NewStyle().Background(lipgloss.Color("#0055AA")).Foreground(lipgloss.Color("#FFFFFF"))
@@ -202,7 +230,8 @@ func (t *AmigaTheme) View(width, height int, q *game.Question, inputView string,
 	innerW := winW - 2
 
 	questionLines := wrapLabeled("1.System:> ", q.Text, innerW)
-	inputLines := wrapLabeled("Answer:> ", inputView+"█", innerW)
+	inRows := inputRows("Answer:> ", inputs, "█", innerW, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
 	var hintLines []string
 	if hint != "" {
 		hintLines = wrapLabeled("Hint: ", hint, innerW)
@@ -217,7 +246,7 @@ func (t *AmigaTheme) View(width, height int, q *game.Question, inputView string,
 		extraH += min(len(questionLines)-1, 3)
 	}
 	if len(inputLines) > 1 {
-		extraH += min(len(inputLines)-1, 1)
+		extraH += len(inputLines) - 1
 	}
 	if len(hintLines) > 1 {
 		extraH += min(len(hintLines)-1, 2)
@@ -300,11 +329,12 @@ func (t *AmigaTheme) View(width, height int, q *game.Question, inputView string,
 		row++
 	}
 
-	for _, line := range inputLines {
+	for i, line := range inputLines {
 		if row >= winY+winH-1 {
 			break
 		}
 		c.SetString(winX+1, row, line, winGrey)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -329,6 +359,7 @@ func (t *AmigaTheme) View(width, height int, q *game.Question, inputView string,
 
 type VHSTheme struct {
 	BaseTheme
+	inputLayout
 	frameCount int
 }
 
@@ -344,7 +375,8 @@ func (t *VHSTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *VHSTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *VHSTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Render plain text first
@@ -354,8 +386,13 @@ func (t *VHSTheme) View(width, height int, q *game.Question, inputView string, h
 	ts := time.Now().Format("PM 03:04:05")
 	c.SetString(2, height-2, "PLAY  SP  "+ts, textStyle)
 
-	content := fmt.Sprintf("%s\n\n> %s", q.Text, inputView)
-	lines := strings.Split(content, "\n")
+	lines := append(strings.Split(q.Text, "\n"), "")
+	inputY := height/3 + len(lines)
+	inRows := inputRows("> ", inputs, "", width-8, inputHeight(inputs))
+	for i, r := range inRows {
+		lines = append(lines, r.text)
+		t.markInput(inputY+i, r)
+	}
 	for i, line := range lines {
 		c.SetString(4, height/3+i, line, textStyle)
 	}
@@ -388,13 +425,15 @@ func (t *VHSTheme) View(width, height int, q *game.Question, inputView string, h
 
 type SovietTheme struct {
 	SlowBaseTheme
+	inputLayout
 }
 
 func NewSovietTheme() Theme                { return &SovietTheme{} }
 func (t *SovietTheme) Name() string        { return "Soviet Terminal" }
 func (t *SovietTheme) Description() string { return "Top Secret / GRU" }
 
-func (t *SovietTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *SovietTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	red := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
@@ -412,11 +451,15 @@ func (t *SovietTheme) View(width, height int, q *game.Question, inputView string
 
 	// Content
 	c.SetString(4, 5, "OBJECTIVE: "+q.Text, red)
-	c.SetString(4, 7, "INPUT DATA: "+inputView, red)
+	inRows := inputRows("INPUT DATA: ", inputs, "", width-6, inputHeight(inputs))
+	for i, r := range inRows {
+		c.SetString(4, 7+i, r.text, red)
+		t.markInput(7+i, r)
+	}
 
 	if hint != "" {
 		// Redacted hint
-		c.SetString(4, 9, "INTELLIGENCE: "+hint, lipgloss.NewStyle().Foreground(lipgloss.Color("#550000")))
+		c.SetString(4, 8+max(len(inRows), 1), "INTELLIGENCE: "+hint, lipgloss.NewStyle().Foreground(lipgloss.Color("#550000")))
 	}
 
 	// Footer
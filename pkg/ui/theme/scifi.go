@@ -17,6 +17,7 @@ import (
 
 type MatrixTheme struct {
 	BaseTheme
+	inputLayout
 	columns     []matrixColumn
 	initialized bool
 }
@@ -56,7 +57,8 @@ func (t *MatrixTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *MatrixTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *MatrixTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Initialize columns if resize or first run
@@ -122,7 +124,9 @@ func (t *MatrixTheme) View(width, height int, q *game.Question, inputView string
 	lines = append(lines, "WAKE UP NEO...", "")
 	lines = append(lines, wrapText(q.Text, textWidth)...)
 	lines = append(lines, "")
-	lines = append(lines, wrapLabeled("> ", inputView, textWidth)...)
+	inputFirst := len(lines)
+	inRows := inputRows("> ", inputs, "", textWidth, max(3, inputHeight(inputs)))
+	lines = append(lines, rowTexts(inRows)...)
 	if hint != "" {
 		lines = append(lines, "")
 		lines = append(lines, wrapLabeled("HINT: ", hint, textWidth)...)
@@ -132,6 +136,11 @@ func (t *MatrixTheme) View(width, height int, q *game.Question, inputView string
 	textStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Background(lipgloss.Color("#001100")).Bold(true)
 
 	currentY := boxY + 2
+	for i, r := range inRows {
+		if inputFirst+i < len(lines) {
+			t.markInput(currentY+inputFirst+i, r)
+		}
+	}
 	for _, line := range lines {
 		if currentY >= boxY+boxHeight-1 {
 			break
@@ -156,6 +165,7 @@ func randomKatakana() rune {
 
 type CyberpunkTheme struct {
 	BaseTheme
+	inputLayout
 	glitchIntensity float64
 	frameCount      int
 }
@@ -180,7 +190,8 @@ func (t *CyberpunkTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *CyberpunkTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *CyberpunkTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	// Styles
@@ -231,13 +242,17 @@ func (t *CyberpunkTheme) View(width, height int, q *game.Question, inputView str
 		row++
 	}
 
-	inputLines := wrapAndClamp("> ", inputView, panelW, 1)
-	if len(inputLines) > 0 && row < height {
-		line := inputLines[0]
+	inRows := inputRows("> ", inputs, "", panelW, inputHeight(inputs))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
+		if row >= height {
+			break
+		}
 		// Chromatic Aberration for Input
 		c.SetString(panelX+2, row, line, cyan) // Shift right
 		c.SetString(panelX-2, row, line, pink) // Shift left
 		c.SetString(panelX, row, line, lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Bold(true))
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -271,6 +286,7 @@ func (t *CyberpunkTheme) View(width, height int, q *game.Question, inputView str
 
 type TronTheme struct {
 	BaseTheme
+	inputLayout
 	gridOffset float64
 }
 
@@ -278,6 +294,18 @@ func NewTronTheme() Theme                { return &TronTheme{} }
 func (t *TronTheme) Name() string        { return "The Grid" }
 func (t *TronTheme) Description() string { return "Digital Frontier" }
 
+func (t *TronTheme) BoardStyle() BoardStyle {
+	cyan := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FFFF"))
+	return BoardStyle{
+		Title:  cyan.Bold(true),
+		Header: cyan.Bold(true),
+		Cell:   cyan,
+		Cursor: lipgloss.NewStyle().Foreground(lipgloss.Color("#000000")).Background(lipgloss.Color("#00FFFF")).Bold(true),
+		Solved: lipgloss.NewStyle().Foreground(lipgloss.Color("#004444")),
+		Status: cyan,
+	}
+}
+
 func (t *TronTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	if _, ok := msg.(game.TickMsg); ok {
 		t.gridOffset += 0.5
@@ -289,7 +317,8 @@ func (t *TronTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *TronTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *TronTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	glowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FFFF")) // Cyan
@@ -353,12 +382,14 @@ func (t *TronTheme) View(width, height int, q *game.Question, inputView string,
 	if row < height {
 		row++
 	}
-	inputLines := wrapAndClamp("> ", inputView, textW, 2)
-	for _, line := range inputLines {
+	inRows := inputRows("> ", inputs, "", textW, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
 		if row >= height {
 			break
 		}
 		c.SetString(textX, row, line, lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")))
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -383,6 +414,7 @@ func (t *TronTheme) View(width, height int, q *game.Question, inputView string,
 
 type AlienTheme struct {
 	BaseTheme
+	inputLayout
 	cursorBlink bool
 }
 
@@ -398,7 +430,8 @@ func (t *AlienTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *AlienTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *AlienTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	c := canvas.New(width, height)
 
 	amber := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFB000")) // Classic Amber
@@ -443,12 +476,14 @@ func (t *AlienTheme) View(width, height int, q *game.Question, inputView string,
 	if row < height-3 {
 		row++
 	}
-	inputLines := clampLines(wrapLabeled("INPUT: ", inputView+cursor, innerW), 2, innerW)
-	for _, line := range inputLines {
+	inRows := inputRows("INPUT: ", inputs, cursor, innerW, max(2, inputHeight(inputs)))
+	inputLines := rowTexts(inRows)
+	for i, line := range inputLines {
 		if row >= height-3 {
 			break
 		}
 		c.SetString(5, row, line, amber)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -473,6 +508,7 @@ func (t *AlienTheme) View(width, height int, q *game.Question, inputView string,
 
 type SystemShockTheme struct {
 	BaseTheme
+	inputLayout
 	corruptionLevel float64
 }
 
@@ -488,7 +524,8 @@ func (t *SystemShockTheme) Update(msg tea.Msg) (Theme, tea.Cmd) {
 	return t, nil
 }
 
-func (t *SystemShockTheme) View(width, height int, q *game.Question, inputView string, hint string) string {
+func (t *SystemShockTheme) View(width, height int, q *game.Question, inputs []Input, hint string) string {
+	t.clearInputs(height)
 	if width <= 0 || height <= 0 {
 		return ""
 	}
@@ -543,12 +580,14 @@ func (t *SystemShockTheme) View(width, height int, q *game.Question, inputView s
 		row++
 	}
 
-	responseLines := wrapAndClamp("RESPONSE REQUIRED: ", inputView, contentW, 2)
-	for _, line := range responseLines {
+	inRows := inputRows("RESPONSE REQUIRED: ", inputs, "", contentW, max(2, inputHeight(inputs)))
+	responseLines := rowTexts(inRows)
+	for i, line := range responseLines {
 		if row >= height {
 			break
 		}
 		c.SetString(contentX, row, line, red)
+		t.markInput(row, inRows[i])
 		row++
 	}
 
@@ -3,6 +3,7 @@ package theme
 import (
 	"ctf-tool/pkg/game"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -175,7 +176,7 @@ func TestThemesRenderQuestionAndInput(t *testing.T) {
 					}
 				}
 
-				view := theme.View(width, height, q, input, q.Hint)
+				view := theme.View(width, height, q, SingleInput(input), q.Hint)
 				clean := stripANSI(view)
 				if got := lineCount(clean); got < height {
 					t.Fatalf("expected at least %d lines, got %d", height, got)
@@ -196,3 +197,157 @@ func TestThemesRenderQuestionAndInput(t *testing.T) {
 		})
 	}
 }
+
+func TestInputRows(t *testing.T) {
+	if got := rowTexts(inputRows("> ", SingleInput("hunter2"), "_", 20, 2)); !reflect.DeepEqual(got, []string{"> hunter2_"}) {
+		t.Errorf("single input = %q", got)
+	}
+	inputs := []Input{
+		{Label: "user", Value: "admin", Solved: true},
+		{Label: "port", Value: "2", Focused: true},
+		{Label: "host"},
+	}
+	want := []string{"  user: admin [OK]", "> port: 2_", "  host: "}
+	if got := rowTexts(inputRows("> ", inputs, "_", 20, 3)); !reflect.DeepEqual(got, want) {
+		t.Errorf("parts = %q, want %q", got, want)
+	}
+	if got := inputRows("> ", inputs, "_", 20, 1); len(got) != 1 || got[0].index != 1 {
+		t.Errorf("clamped parts = %v, want only the focused one", got)
+	}
+	choices := ChoiceInputs([]string{"telnet", "ssh"}, 1)
+	if got, want := rowTexts(inputRows("> ", choices, "_", 20, 2)), []string{"  1) telnet", "> 2) ssh"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("choices = %q, want %q", got, want)
+	}
+}
+
+// rowsOnOwnLines reports whether every want is in view, each on a line of
+// its own.
+func rowsOnOwnLines(view string, want ...string) bool {
+	lines := strings.Split(view, "\n")
+	seen := make(map[int]bool)
+	for _, w := range want {
+		found := false
+		for i, line := range lines {
+			if strings.Contains(line, w) && !seen[i] {
+				seen[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestThemesListEveryChoiceAndPart(t *testing.T) {
+	q := &game.Question{ID: 1, Text: "Which port does SSH use?"}
+	choices := ChoiceInputs([]string{"telnet", "ssh", "http"}, 1)
+	parts := []Input{
+		{Label: "user", Value: "admin", Solved: true},
+		{Label: "port", Value: "22", Focused: true},
+	}
+
+	for _, constructor := range Registry {
+		theme := constructor()
+		t.Run(theme.Name(), func(t *testing.T) {
+			// Like above, glitch layers may hide a row in some frames.
+			sawChoices, sawParts := false, false
+			for frame := 0; frame < 30 && !(sawChoices && sawParts); frame++ {
+				if frame > 0 {
+					if next, _ := theme.Update(game.TickMsg(time.Now())); next != nil {
+						theme = next
+					}
+				}
+				sawChoices = sawChoices || rowsOnOwnLines(stripANSI(theme.View(80, 22, q, choices, "")), "1) telnet", "2) ssh", "3) http")
+				sawParts = sawParts || rowsOnOwnLines(stripANSI(theme.View(80, 22, q, parts, "")), "user: admin", "port: 22")
+			}
+			if !sawChoices {
+				t.Errorf("theme output did not list every choice on its own row")
+			}
+			if !sawParts {
+				t.Errorf("theme output did not show every part on its own row")
+			}
+		})
+	}
+}
+
+func TestThemesLocateEveryChoice(t *testing.T) {
+	q := &game.Question{ID: 1, Text: "Which port does SSH use?"}
+	inputs := ChoiceInputs([]string{"telnet", "ssh", "http"}, 1)
+
+	for _, constructor := range Registry {
+		theme := constructor()
+		t.Run(theme.Name(), func(t *testing.T) {
+			// Glitch layers may cover a row in some frames.
+			var err string
+			for frame := 0; frame < 30; frame++ {
+				if frame > 0 {
+					if next, _ := theme.Update(game.TickMsg(time.Now())); next != nil {
+						theme = next
+					}
+				}
+				lines := strings.Split(stripANSI(theme.View(80, 22, q, inputs, "a hint")), "\n")
+				locator, ok := theme.(InputLocator)
+				if !ok {
+					t.Fatalf("theme does not implement InputLocator")
+				}
+				found := make(map[int]bool)
+				err = ""
+				for y, line := range lines {
+					i, ok := locator.InputAt(y)
+					if !ok {
+						continue
+					}
+					if !strings.Contains(line, ChoiceLabel(inputs[i])) {
+						err = fmt.Sprintf("row %d is located as %q but shows %q", y, ChoiceLabel(inputs[i]), line)
+						break
+					}
+					found[i] = true
+				}
+				if err == "" && len(found) < len(inputs) {
+					err = fmt.Sprintf("located %d of %d choices", len(found), len(inputs))
+				}
+				if err == "" {
+					return
+				}
+			}
+			t.Fatal(err)
+		})
+	}
+}
+
+func TestFormatCountdown(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		-time.Second:            "0:00",
+		0:                       "0:00",
+		1500 * time.Millisecond: "0:02",
+		90 * time.Second:        "1:30",
+		time.Hour + 5*time.Minute + 9*time.Second: "1:05:09",
+	} {
+		if got := FormatCountdown(d); got != want {
+			t.Errorf("FormatCountdown(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestRenderBoardFitsSmallTerminals(t *testing.T) {
+	board := Board{
+		Categories: []BoardCategory{
+			{Name: "Web", Cells: []BoardCell{{Points: 100}, {Points: 200, Solved: true}}},
+			{Name: "Crypto", Cells: []BoardCell{{Points: 100, Closed: true}}},
+		},
+		Status: "SCORE 0",
+	}
+	for _, size := range [][2]int{{80, 24}, {40, 10}} {
+		view := RenderBoard(size[0], size[1], board, DefaultBoardStyle)
+		if lines := strings.Split(view, "\n"); len(lines) != size[1] {
+			t.Fatalf("%dx%d: got %d lines", size[0], size[1], len(lines))
+		}
+		for _, want := range []string{"WEB", "100", "LOCKED"} {
+			if !strings.Contains(view, want) {
+				t.Fatalf("%dx%d: missing %q in\n%s", size[0], size[1], want, view)
+			}
+		}
+	}
+}
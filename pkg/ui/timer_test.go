@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"ctf-tool/pkg/game"
+	"testing"
+	"time"
+)
+
+func TestQuestionTimeLimitActions(t *testing.T) {
+	for _, tt := range []struct {
+		action    string
+		wantState GameState
+		wantIndex int
+	}{
+		{game.ExpireRevealHint, StateQuestion, 0},
+		{game.ExpireSkip, StateTransition, 1},
+		{game.ExpireEnd, StateSuccess, 0},
+	} {
+		t.Run(tt.action, func(t *testing.T) {
+			cfg := &game.Config{
+				Questions: []game.Question{
+					{ID: 1, Text: "Q1", Answer: "A1", Hints: []game.Hint{{Text: "h1"}, {Text: "h2"}}, TimeLimit: 30, OnExpire: tt.action},
+					{ID: 2, Text: "Q2", Answer: "A2"},
+				},
+				FinalMessage: "EGG",
+			}
+			m := NewModel(cfg)
+			m.State = StateQuestion
+
+			start := time.Now()
+			for _, at := range []time.Duration{0, 10 * time.Second, 31 * time.Second} {
+				next, _ := m.Update(game.TickMsg(start.Add(at)))
+				m = next.(Model)
+			}
+
+			if m.State != tt.wantState || m.CurrentQuestionIndex != tt.wantIndex {
+				t.Fatalf("state=%s index=%d, want %s index %d", gameStateName(m.State), m.CurrentQuestionIndex, gameStateName(tt.wantState), tt.wantIndex)
+			}
+			switch tt.action {
+			case game.ExpireRevealHint:
+				if m.HintsRevealed != 2 {
+					t.Errorf("expected all hints revealed, got %d", m.HintsRevealed)
+				}
+			case game.ExpireEnd:
+				if !m.TimedOut || m.finalMessage() != game.DefaultTimeUpMessage {
+					t.Errorf("expected time's up finale, got %q", m.finalMessage())
+				}
+			}
+		})
+	}
+}
+
+func TestGameDeadlineEndsGame(t *testing.T) {
+	cfg := &game.Config{
+		Questions:     []game.Question{{ID: 1, Text: "Q1", Answer: "A1"}},
+		FinalMessage:  "EGG",
+		TimeLimit:     60,
+		TimeUpMessage: "too slow",
+	}
+	m := NewModel(cfg)
+	m.State = StateQuestion
+
+	start := time.Now()
+	next, _ := m.Update(game.TickMsg(start))
+	m = next.(Model)
+	if h := m.hud(); !h.GameTimed || h.GameLeft != time.Minute {
+		t.Fatalf("expected a full minute on the HUD, got %+v", h)
+	}
+
+	next, _ = m.Update(game.TickMsg(start.Add(time.Minute)))
+	m = next.(Model)
+	if m.State != StateSuccess || !m.TimedOut || m.finalMessage() != "too slow" {
+		t.Fatalf("expected the deadline to end the game, state=%s message=%q", gameStateName(m.State), m.finalMessage())
+	}
+}
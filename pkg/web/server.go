@@ -12,18 +12,23 @@
 package web
 
 import (
+	"bufio"
 	"context"
 	"embed"
 	"encoding/binary"
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
+	"strings"
 	"sync"
 	"time"
 
+	"ctf-tool/pkg/game"
+
 	"github.com/creack/pty"
 	"nhooyr.io/websocket"
 )
@@ -41,8 +46,11 @@ const (
 
 // Serve starts the web terminal server on the given address (e.g. ":8080").
 // selfPath is the absolute path to the running binary so we can re-exec it
-// without the -web flag.
-func Serve(addr string, selfPath string, extraArgs []string) error {
+// without the -web flag. Requests from a trusted proxy are attributed to the
+// client the proxy names (see ParseProxies).
+func Serve(addr string, selfPath string, extraArgs []string, proxies []*net.IPNet) error {
+	locks := &lockouts{clients: make(map[string]*client)}
+
 	mux := http.NewServeMux()
 
 	// Serve the static HTML/JS frontend.
@@ -59,14 +67,153 @@ func Serve(addr string, selfPath string, extraArgs []string) error {
 
 	// WebSocket endpoint — one connection = one PTY session.
 	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		handleWS(w, r, selfPath, extraArgs)
+		handleWS(w, r, selfPath, extraArgs, clientAddr(r, proxies), locks)
 	})
 
 	log.Printf("web terminal listening on %s", addr)
 	return http.ListenAndServe(addr, mux)
 }
 
-func handleWS(w http.ResponseWriter, r *http.Request, selfPath string, extraArgs []string) {
+// ParseProxies parses a comma-separated list of IP addresses and CIDR
+// ranges, such as "127.0.0.1,10.0.0.0/8".
+func ParseProxies(list string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid proxy address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy range %q", entry)
+		}
+		proxies = append(proxies, ipnet)
+	}
+	return proxies, nil
+}
+
+// clientAddr is the client's IP address without the port. Behind a trusted
+// proxy every connection comes from the proxy, so there it is the address
+// the proxy passes in X-Real-IP or, failing that, the last X-Forwarded-For
+// entry, which the proxy appended itself. Anyone else's headers are ignored,
+// since a client could name any address in them.
+func clientAddr(r *http.Request, proxies []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer := net.ParseIP(host)
+	if peer == nil || !trusted(peer, proxies) {
+		return host
+	}
+	forwarded := strings.TrimSpace(r.Header.Get("X-Real-IP"))
+	if forwarded == "" {
+		hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+		forwarded = strings.TrimSpace(hops[len(hops)-1])
+	}
+	if ip := net.ParseIP(forwarded); ip != nil {
+		return ip.String()
+	}
+	return host
+}
+
+func trusted(ip net.IP, proxies []*net.IPNet) bool {
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// lockouts shares lockout state between the games of one client address.
+// The session token and -no-save are up to the client, so only the address
+// keeps a new session from shaking off a running lockout. Every running
+// game keeps its own state: a new game starts with all of them merged, a
+// lockout in one is pushed to the others, and a solve only clears the game
+// it happened in.
+type lockouts struct {
+	mu      sync.Mutex
+	clients map[string]*client
+}
+
+// client is the lockout state of one address.
+type client struct {
+	left    game.Lockout // merged state of the games that ended
+	running map[*session]bool
+}
+
+// session is one running game.
+type session struct {
+	addr  string
+	state game.Lockout
+	push  io.Writer // the game's -lockouts-fd, or nil
+}
+
+// join registers a game of addr, which is sent every lockout another game
+// of the address starts on push, and returns the state it starts with.
+func (l *lockouts) join(addr string, push io.Writer) (*session, game.Lockout) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	c := l.clients[addr]
+	if c == nil {
+		c = &client{running: make(map[*session]bool)}
+		l.clients[addr] = c
+	}
+	state := c.left
+	for s := range c.running {
+		state.Merge(s.state)
+	}
+	s := &session{addr: addr, state: state, push: push}
+	c.running[s] = true
+	return s, state
+}
+
+// report stores the state game s reported and returns the one it replaces.
+// A new lockout is pushed to the other games of the address.
+func (l *lockouts) report(s *session, state game.Lockout, now time.Time) game.Lockout {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	prev := s.state
+	s.state = state
+	if state.Remaining(now) <= 0 || !state.Until.After(prev.Until) {
+		return prev
+	}
+	line := fmt.Sprintf("lockout %s\n", game.FormatLockout(state))
+	for other := range l.clients[s.addr].running {
+		if other != s && other.push != nil {
+			io.WriteString(other.push, line)
+		}
+	}
+	return prev
+}
+
+// leave unregisters game s, keeping its state for the next game of the
+// address.
+func (l *lockouts) leave(s *session) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	c := l.clients[s.addr]
+	c.left.Merge(s.state)
+	delete(c.running, s)
+	if len(c.running) == 0 && c.left == (game.Lockout{}) {
+		delete(l.clients, s.addr)
+	}
+}
+
+func handleWS(w http.ResponseWriter, r *http.Request, selfPath string, extraArgs []string, addr string, locks *lockouts) {
 	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
 		// Allow any origin for local development.
 		InsecureSkipVerify: true,
@@ -80,14 +227,54 @@ func handleWS(w http.ResponseWriter, r *http.Request, selfPath string, extraArgs
 	ctx, cancel := context.WithCancel(r.Context())
 	defer cancel()
 
-	// Spawn the ctf-tool (ourselves without -web) inside a PTY.
-	cmd := exec.CommandContext(ctx, selfPath, extraArgs...)
+	// Spawn the ctf-tool (ourselves without -web) inside a PTY. The browser
+	// keeps a session token so a reconnect resumes the same saved game, and
+	// may pass the player's name on from the page URL. Without a token the
+	// game isn't saved, or every such browser would share one save file.
+	args := append([]string{}, extraArgs...)
+	if session := r.URL.Query().Get("session"); game.ValidSession(session) {
+		args = append(args, "-session", session)
+	} else {
+		args = append(args, "-no-save")
+	}
+	if player := game.CleanPlayerName(r.URL.Query().Get("player")); player != "" {
+		args = append(args, "-player", player)
+	}
+	// The game reports its lockout state on a pipe of its own (fd 3), since
+	// its terminal output belongs to the browser, and is sent the lockouts
+	// of its client's other games on another (fd 4). It starts with the
+	// state its client's games left.
+	events, eventsW, err := os.Pipe()
+	if err != nil {
+		log.Printf("events pipe: %v", err)
+		conn.Close(websocket.StatusInternalError, fmt.Sprintf("pipe: %v", err))
+		return
+	}
+	defer events.Close()
+	pushR, push, err := os.Pipe()
+	if err != nil {
+		eventsW.Close()
+		log.Printf("lockouts pipe: %v", err)
+		conn.Close(websocket.StatusInternalError, fmt.Sprintf("pipe: %v", err))
+		return
+	}
+	defer push.Close()
+	sess, state := locks.join(addr, push)
+	defer locks.leave(sess)
+	args = append(args, "-events-fd", "3", "-lockouts-fd", "4")
+	if state != (game.Lockout{}) {
+		args = append(args, "-lockout", game.FormatLockout(state))
+	}
+	cmd := exec.CommandContext(ctx, selfPath, args...)
 	cmd.Env = append(os.Environ(),
 		"TERM=xterm-256color",
 		"COLORTERM=truecolor",
 	)
+	cmd.ExtraFiles = []*os.File{eventsW, pushR}
 
 	ptmx, err := pty.Start(cmd)
+	eventsW.Close()
+	pushR.Close()
 	if err != nil {
 		log.Printf("pty start: %v", err)
 		conn.Close(websocket.StatusInternalError, fmt.Sprintf("pty: %v", err))
@@ -95,6 +282,14 @@ func handleWS(w http.ResponseWriter, r *http.Request, selfPath string, extraArgs
 	}
 	defer ptmx.Close()
 
+	tracked := make(chan struct{})
+	go func() {
+		defer close(tracked)
+		trackEvents(events, sessionLabel(r), sess, locks)
+	}()
+	// The game's last report must be in before it leaves.
+	defer func() { <-tracked }()
+
 	var wg sync.WaitGroup
 
 	// --- PTY → WebSocket (with frame batching) ---
@@ -124,6 +319,37 @@ func handleWS(w http.ResponseWriter, r *http.Request, selfPath string, extraArgs
 	wg.Wait()
 }
 
+// sessionLabel names a browser session in the log: its session token, or
+// its address without one.
+func sessionLabel(r *http.Request) string {
+	if session := r.URL.Query().Get("session"); game.ValidSession(session) {
+		return "session " + session
+	}
+	return "client " + r.RemoteAddr
+}
+
+// trackEvents records the lockout state game s reports until it exits, and
+// logs every new lockout. A lockout line is "lockout " followed by
+// game.FormatLockout.
+func trackEvents(r io.Reader, label string, s *session, locks *lockouts) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		text, ok := strings.CutPrefix(scanner.Text(), "lockout ")
+		if !ok {
+			continue
+		}
+		state, err := game.ParseLockout(text)
+		if err != nil {
+			continue
+		}
+		now := time.Now()
+		prev := locks.report(s, state, now)
+		if left := state.Remaining(now); left > 0 && state.Count > prev.Count {
+			log.Printf("%s locked out (#%d) for %s after repeated wrong answers", label, state.Count, left.Round(time.Second))
+		}
+	}
+}
+
 // batchedWriter reads from the PTY and flushes to the WebSocket at most once
 // per flushInterval.  If the WebSocket write would block, intermediate data is
 // dropped (the next full flush will contain the latest state).
@@ -0,0 +1,152 @@
+package web
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"ctf-tool/pkg/game"
+)
+
+// behindProxy starts a server that answers with the client address it sees,
+// and a reverse proxy in front of it that passes on the address the way
+// nginx.conf does. Every request reaches the server from 127.0.0.1, so the
+// test names the client the proxy should forward in X-Test-Client.
+func behindProxy(t *testing.T, proxies []*net.IPNet) (proxy, direct string) {
+	t.Helper()
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, clientAddr(r, proxies))
+	}))
+	t.Cleanup(backend.Close)
+	target, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	front := httptest.NewServer(&httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(target)
+			client := pr.In.Header.Get("X-Test-Client")
+			pr.Out.Header.Set("X-Real-IP", client)
+			pr.Out.Header.Set("X-Forwarded-For", pr.In.Header.Get("X-Forwarded-For")+", "+client)
+		},
+	})
+	t.Cleanup(front.Close)
+	return front.URL, backend.URL
+}
+
+func seenAs(t *testing.T, url string, header map[string]string) string {
+	t.Helper()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestClientAddrBehindTrustedProxy(t *testing.T) {
+	proxies, err := ParseProxies("127.0.0.1, ::1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy, _ := behindProxy(t, proxies)
+
+	for _, client := range []string{"203.0.113.7", "198.51.100.2"} {
+		got := seenAs(t, proxy, map[string]string{
+			"X-Test-Client":   client,
+			"X-Forwarded-For": "10.9.9.9", // spoofed by the client
+		})
+		if got != client {
+			t.Errorf("client %s seen as %q", client, got)
+		}
+	}
+}
+
+func TestClientAddrIgnoresHeadersFromOthers(t *testing.T) {
+	proxies, err := ParseProxies("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy, direct := behindProxy(t, proxies)
+
+	if got := seenAs(t, proxy, map[string]string{"X-Test-Client": "203.0.113.7"}); got != "127.0.0.1" {
+		t.Errorf("untrusted proxy: client seen as %q, want the proxy's address", got)
+	}
+	if got := seenAs(t, direct, map[string]string{"X-Real-IP": "203.0.113.7"}); got != "127.0.0.1" {
+		t.Errorf("direct request: client seen as %q, want its own address", got)
+	}
+}
+
+func TestParseProxies(t *testing.T) {
+	proxies, err := ParseProxies("127.0.0.1,10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ip, want := range map[string]bool{"127.0.0.1": true, "127.0.0.2": false, "10.1.2.3": true, "::1": false} {
+		if got := trusted(net.ParseIP(ip), proxies); got != want {
+			t.Errorf("trusted(%s) = %v, want %v", ip, got, want)
+		}
+	}
+	for _, bad := range []string{"localhost", "10.0.0.0/33"} {
+		if _, err := ParseProxies(bad); err == nil {
+			t.Errorf("ParseProxies(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestLockoutsReachEveryGameOfAClient(t *testing.T) {
+	now := time.Now()
+	locks := &lockouts{clients: make(map[string]*client)}
+	var pushA, pushB, pushOther bytes.Buffer
+	a, _ := locks.join("203.0.113.7", &pushA)
+	b, _ := locks.join("203.0.113.7", &pushB)
+	locks.join("198.51.100.2", &pushOther)
+
+	locked := game.Lockout{Count: 1, Until: now.Add(time.Minute)}
+	locks.report(a, locked, now)
+	if want := "lockout " + game.FormatLockout(locked) + "\n"; pushB.String() != want {
+		t.Errorf("other game of the client was sent %q, want %q", pushB.String(), want)
+	}
+	if pushA.Len() != 0 || pushOther.Len() != 0 {
+		t.Errorf("lockout sent to its own game (%q) or another client (%q)", pushA.String(), pushOther.String())
+	}
+
+	// A further report of the same lockout isn't pushed again.
+	locks.report(a, game.Lockout{Strikes: 1, Count: 1, Until: locked.Until}, now)
+	if strings.Count(pushB.String(), "\n") != 1 {
+		t.Errorf("unchanged lockout pushed again: %q", pushB.String())
+	}
+
+	// A solve in b doesn't clear a's lockout for new games.
+	locks.report(b, game.Lockout{}, now)
+	if _, state := locks.join("203.0.113.7", nil); state.Remaining(now) <= 0 {
+		t.Errorf("new game starts with %+v after a solve elsewhere, want a's lockout", state)
+	}
+
+	// Nor does a game that ended take its lockout along.
+	locks.leave(a)
+	if _, state := locks.join("203.0.113.7", nil); state.Count != 1 || state.Remaining(now) <= 0 {
+		t.Errorf("new game starts with %+v after a locked game ended", state)
+	}
+	if _, state := locks.join("198.51.100.2", nil); state != (game.Lockout{}) {
+		t.Errorf("another client starts with %+v", state)
+	}
+}